@@ -80,6 +80,7 @@ func New(addr string, c pb.ControllerClient) *Server {
 	router.GET("/games/:id/frames", logging(newClientHandle(c, getFrames)))
 	router.GET("/socket/:id", logging(newClientHandle(c, framesSocket)))
 	router.GET("/validateSnake", logging(newClientHandle(c, validateSnake)))
+	router.GET("/scores", logging(newClientHandle(c, getBestScores)))
 
 	router.GET("/healthz/alive", logging(newClientHandle(c, getAlive)))
 	router.GET("/healthz/ready", logging(newClientHandle(c, getReady)))
@@ -375,6 +376,35 @@ func validateSnake(w http.ResponseWriter, r *http.Request, ps httprouter.Params,
 	}
 }
 
+func getBestScores(w http.ResponseWriter, r *http.Request, ps httprouter.Params, c pb.ControllerClient) {
+	queryValues := r.URL.Query()
+	url := queryValues.Get("url")
+	if url == "" {
+		err := errors.New("url parameter not provided")
+		writeError(w, err, http.StatusBadRequest, "You must provide a url parameter", nil)
+		return
+	}
+	width, _ := strconv.ParseInt(queryValues.Get("width"), 10, 32)   // nolint: gas, gosec
+	height, _ := strconv.ParseInt(queryValues.Get("height"), 10, 32) // nolint: gas, gosec
+	req := &pb.BestScoresRequest{
+		SnakeURL: url,
+		Width:    int32(width),
+		Height:   int32(height),
+	}
+
+	resp, err := c.BestScores(r.Context(), req)
+	if err != nil {
+		writeError(w, err, http.StatusInternalServerError, "Error while calling controller best scores", nil)
+		return
+	}
+
+	m := jsonpb.Marshaler{EmitDefaults: true}
+	err = m.Marshal(w, resp)
+	if err != nil {
+		log.WithError(err).Error("Unable to write response to stream")
+	}
+}
+
 func getAlive(w http.ResponseWriter, r *http.Request, ps httprouter.Params, c pb.ControllerClient) {
 	fmt.Fprint(w, "alive")
 }
@@ -25,6 +25,7 @@ type MockController struct {
 	StatusResponse         *pb.StatusResponse
 	ValidateSnakeResponse  *pb.ValidateSnakeResponse
 	ListGameFramesResponse func() *pb.ListGameFramesResponse
+	BestScoresRequest      *pb.BestScoresRequest
 }
 
 func (mc *MockController) Create(ctx context.Context, req *pb.CreateRequest, opts ...grpc.CallOption) (*pb.CreateResponse, error) {
@@ -46,6 +47,11 @@ func (mc *MockController) ValidateSnake(ctx context.Context, req *pb.ValidateSna
 	return mc.ValidateSnakeResponse, mc.Error
 }
 
+func (mc *MockController) BestScores(ctx context.Context, req *pb.BestScoresRequest, opts ...grpc.CallOption) (*pb.BestScoresResponse, error) {
+	mc.BestScoresRequest = req
+	return &pb.BestScoresResponse{}, mc.Error
+}
+
 func createAPIServer() (*Server, *MockController) {
 	var client = &MockController{
 		CreateResponse:        &pb.CreateResponse{},
@@ -193,6 +199,37 @@ func TestValidateSnakeMissingUrl(t *testing.T) {
 	require.Equal(t, http.StatusBadRequest, rr.Code)
 }
 
+func TestGetBestScores(t *testing.T) {
+	s, client := createAPIServer()
+
+	req, _ := http.NewRequest("GET", "/scores?url=dsnek.heroku.com&width=11&height=7", nil)
+	rr := httptest.NewRecorder()
+
+	s.hs.Handler.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, &pb.BestScoresRequest{SnakeURL: "dsnek.heroku.com", Width: 11, Height: 7}, client.BestScoresRequest)
+}
+
+func TestGetBestScoresMissingUrl(t *testing.T) {
+	s, _ := createAPIServer()
+
+	req, _ := http.NewRequest("GET", "/scores", nil)
+	rr := httptest.NewRecorder()
+
+	s.hs.Handler.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestGetBestScoresWithControllerError(t *testing.T) {
+	s, _ := createAPIServerWithError(errors.New("uh oh"))
+
+	req, _ := http.NewRequest("GET", "/scores?url=dsnek.heroku.com", nil)
+	rr := httptest.NewRecorder()
+
+	s.hs.Handler.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusInternalServerError, rr.Code)
+}
+
 func TestGetFramesWithControllerError(t *testing.T) {
 	s, _ := createAPIServerWithError(errors.New("uh oh"))
 
@@ -290,6 +327,46 @@ func TestGetFramesContainsZeroValues(t *testing.T) {
 	require.Equal(t, 0, castPointInArray(food, 2, "Y"))
 }
 
+func TestGetFramesContainsKiller(t *testing.T) {
+	s, mc := createAPIServer()
+	mc.ListGameFramesResponse = func() *pb.ListGameFramesResponse {
+		return &pb.ListGameFramesResponse{
+			Frames: []*pb.GameFrame{
+				{
+					Snakes: []*pb.Snake{
+						{
+							ID:   "snake_123",
+							Body: []*pb.Point{{X: 1, Y: 1}},
+							Death: &pb.Death{
+								Cause:    rules.DeathCauseSnakeCollision,
+								Turn:     4,
+								KilledBy: "snake_456",
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	req, _ := http.NewRequest("GET", "/games/abc_123/frames", nil)
+	rr := httptest.NewRecorder()
+
+	s.hs.Handler.ServeHTTP(rr, req)
+	body, err := ioutil.ReadAll(rr.Body)
+	require.NoError(t, err)
+
+	var resp map[string]interface{}
+	err = json.Unmarshal(body, &resp)
+	require.NoError(t, err)
+
+	frames := castJSONInterface(resp["Frames"], 0)
+	snake := castJSONInterface(frames["Snakes"], 0)
+	death := snake["Death"].(map[string]interface{})
+	require.Equal(t, rules.DeathCauseSnakeCollision, death["Cause"])
+	require.Equal(t, "snake_456", death["KilledBy"])
+}
+
 func TestHealthAlive(t *testing.T) {
 	s, _ := createAPIServer()
 
@@ -6,6 +6,7 @@ import (
 	"math/rand"
 
 	"github.com/battlesnakeio/engine/controller/pb"
+	"github.com/battlesnakeio/engine/rules"
 	"github.com/mattn/go-runewidth"
 	termbox "github.com/nsf/termbox-go"
 )
@@ -14,6 +15,8 @@ const (
 	defaultColor = termbox.ColorDefault
 	bgColor      = termbox.ColorDefault
 	snakeColor   = termbox.ColorGreen
+	hazardColor  = termbox.ColorRed
+	wallColor    = termbox.ColorWhite
 )
 
 func render(game *pb.Game, frame *pb.GameFrame) error {
@@ -35,6 +38,8 @@ func render(game *pb.Game, frame *pb.GameFrame) error {
 
 	renderTitle(left, top, int(frame.Turn))
 	renderBoard(game, top, bottom, left)
+	renderHazards(left, top, frame.Hazards)
+	renderWalls(left, top, frame.Walls)
 	snakePos := 0
 	for _, s := range frame.Snakes {
 		renderSnake(left, top, s)
@@ -54,7 +59,7 @@ func render(game *pb.Game, frame *pb.GameFrame) error {
 		}
 		snakePos += 2
 	}
-	renderFood(left, top, frame.Food)
+	renderFood(left, top, frame.Food, frame.FoodItems)
 
 	return termbox.Flush()
 }
@@ -65,10 +70,34 @@ func renderSnake(left, top int, s *pb.Snake) {
 	}
 }
 
-func renderFood(left, top int, food []*pb.Point) {
+func renderHazards(left, top int, hazards []*pb.Point) {
+	for _, h := range hazards {
+		termbox.SetCell(left+int(h.X), top+int(h.Y)+1, '░', hazardColor, bgColor)
+	}
+}
+
+func renderWalls(left, top int, walls []*pb.Point) {
+	for _, w := range walls {
+		termbox.SetCell(left+int(w.X), top+int(w.Y)+1, '█', wallColor, bgColor)
+	}
+}
+
+func renderFood(left, top int, food []*pb.Point, items []*pb.FoodItem) {
 	for _, f := range food {
 		termbox.SetCell(left+int(f.X), top+int(f.Y)+1, getFoodEmoji(f.X, f.Y), defaultColor, bgColor)
 	}
+	// food that isn't normal food is drawn over with its own symbol
+	for _, item := range items {
+		if r, ok := foodItemRunes[item.Type]; ok {
+			termbox.SetCell(left+int(item.Point.X), top+int(item.Point.Y)+1, r, defaultColor, bgColor)
+		}
+	}
+}
+
+var foodItemRunes = map[string]rune{
+	rules.FoodTypeGolden: '⭐',
+	rules.FoodTypePoison: '💀',
+	rules.FoodTypeShrink: '🔻',
 }
 
 var foods = map[string]rune{}
@@ -106,9 +135,14 @@ func randomFoodEmoji() rune {
 }
 
 func renderBoard(game *pb.Game, top, bottom, left int) {
+	vertical, horizontal := '│', '─'
+	if game.Wrapped {
+		// dashed edges show that snakes pass through to the other side
+		vertical, horizontal = '┆', '┄'
+	}
 	for i := top + 1; i < bottom; i++ {
-		termbox.SetCell(left-1, i, '│', defaultColor, bgColor)
-		termbox.SetCell(left+int(game.Width), i, '│', defaultColor, bgColor)
+		termbox.SetCell(left-1, i, vertical, defaultColor, bgColor)
+		termbox.SetCell(left+int(game.Width), i, vertical, defaultColor, bgColor)
 	}
 
 	termbox.SetCell(left-1, top, '┌', defaultColor, bgColor)
@@ -116,8 +150,8 @@ func renderBoard(game *pb.Game, top, bottom, left int) {
 	termbox.SetCell(left+int(game.Width), top, '┐', defaultColor, bgColor)
 	termbox.SetCell(left+int(game.Width), bottom, '┘', defaultColor, bgColor)
 
-	fill(left, top, int(game.Width), 1, termbox.Cell{Ch: '─'})
-	fill(left, bottom, int(game.Width), 1, termbox.Cell{Ch: '─'})
+	fill(left, top, int(game.Width), 1, termbox.Cell{Ch: horizontal})
+	fill(left, bottom, int(game.Width), 1, termbox.Cell{Ch: horizontal})
 }
 
 func renderTitle(left, top, turn int) {
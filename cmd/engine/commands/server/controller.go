@@ -9,6 +9,7 @@ import (
 	"github.com/battlesnakeio/engine/controller"
 	"github.com/battlesnakeio/engine/controller/filestore"
 	"github.com/battlesnakeio/engine/controller/redis"
+	"github.com/battlesnakeio/engine/rules"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -17,12 +18,16 @@ var (
 	controllerListen      = ":3004"
 	controllerBackend     = "inmem"
 	controllerBackendArgs = ""
+	controllerMaps        = ""
+	controllerScenarios   = ""
 )
 
 func init() {
 	controllerCmd.Flags().StringVarP(&controllerListen, "listen", "l", controllerListen, "address for the controller to bind to")
 	controllerCmd.Flags().StringVarP(&controllerBackend, "backend", "b", controllerBackend, "controller backend, as one of: [inmem, file, redis, sql]")
 	controllerCmd.Flags().StringVarP(&controllerBackendArgs, "backend-args", "a", controllerBackendArgs, "options to pass to the backend being used")
+	controllerCmd.Flags().StringVar(&controllerMaps, "maps", controllerMaps, "directory of board map json files that games can be played on")
+	controllerCmd.Flags().StringVar(&controllerScenarios, "scenarios", controllerScenarios, "directory of scenario json files for the scenario ruleset")
 	RootCmd.Flags().AddFlagSet(controllerCmd.Flags())
 }
 
@@ -31,6 +36,17 @@ var controllerCmd = &cobra.Command{
 	Short:  "runs the engine controller",
 	PreRun: func(c *cobra.Command, args []string) { prometheus() },
 	Run: func(c *cobra.Command, args []string) {
+		if controllerMaps != "" {
+			if err := rules.LoadMaps(controllerMaps); err != nil {
+				log.WithError(err).WithField("maps", controllerMaps).Fatal("unable to load maps")
+			}
+		}
+		if controllerScenarios != "" {
+			if err := rules.LoadScenarios(controllerScenarios); err != nil {
+				log.WithError(err).WithField("scenarios", controllerScenarios).Fatal("unable to load scenarios")
+			}
+		}
+
 		var store controller.Store
 		var err error
 		switch controllerBackend {
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"sort"
 	"strconv"
 	"time"
 
@@ -219,8 +220,46 @@ func (s *Server) ListGameFrames(ctx context.Context, req *pb.ListGameFramesReque
 	}, nil
 }
 
-// EndGame sets the game status to complete. A lock must be held for this call
-// to succeed.
+// BestScores returns a snake's best solo score on each board size it has
+// played, optionally only for boards of the requested width and height. Scores
+// are ordered by board size.
+func (s *Server) BestScores(ctx context.Context, req *pb.BestScoresRequest) (*pb.BestScoresResponse, error) {
+	if req.SnakeURL == "" {
+		return nil, status.Error(codes.InvalidArgument, "controller: snake url is required")
+	}
+	scores, err := s.Store.ListSoloScores(ctx, req.SnakeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	type boardSize struct{ width, height int32 }
+	best := map[boardSize]*pb.SoloScore{}
+	for _, score := range scores {
+		if (req.Width > 0 && score.Width != req.Width) || (req.Height > 0 && score.Height != req.Height) {
+			continue
+		}
+		size := boardSize{score.Width, score.Height}
+		if b, ok := best[size]; !ok || rules.BetterSoloScore(score, b) {
+			best[size] = score
+		}
+	}
+
+	resp := &pb.BestScoresResponse{Scores: []*pb.SoloScore{}}
+	for _, score := range best {
+		resp.Scores = append(resp.Scores, score)
+	}
+	sort.Slice(resp.Scores, func(i, j int) bool {
+		a, b := resp.Scores[i], resp.Scores[j]
+		if a.Width != b.Width {
+			return a.Width < b.Width
+		}
+		return a.Height < b.Height
+	})
+	return resp, nil
+}
+
+// EndGame sets the game status to complete and records the result of the game
+// if there is one. A lock must be held for this call to succeed.
 func (s *Server) EndGame(ctx context.Context, req *pb.EndGameRequest) (*pb.EndGameResponse, error) {
 	token := pb.ContextGetLockToken(ctx)
 
@@ -232,6 +271,13 @@ func (s *Server) EndGame(ctx context.Context, req *pb.EndGameRequest) (*pb.EndGa
 	}
 	token = newToken
 
+	if req.Result != nil {
+		err = s.Store.SetGameResult(ctx, req.ID, req.Result)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = s.Store.SetGameStatus(ctx, req.ID, rules.GameStatusComplete)
 	if err != nil {
 		return nil, err
@@ -127,12 +127,14 @@ func TestController_GameCRUD(t *testing.T) {
 	})
 
 	t.Run("EndGame", func(t *testing.T) {
+		result := &pb.GameResult{Draw: true, Reason: rules.ResultReasonEliminated}
 		_, err := client.EndGame(
-			pb.ContextWithLockToken(ctx, token), &pb.EndGameRequest{ID: gameID})
+			pb.ContextWithLockToken(ctx, token), &pb.EndGameRequest{ID: gameID, Result: result})
 		require.Nil(t, err)
 		g, err := store.GetGame(ctx, gameID)
 		require.NoError(t, err)
 		require.Equal(t, rules.GameStatusComplete, rules.GameStatus(g.Status))
+		require.Equal(t, result, g.Result)
 	})
 
 	t.Run("StartGameOnCompletedGame", func(t *testing.T) {
@@ -281,6 +283,38 @@ func TestController_PopConcurrent(t *testing.T) {
 	require.Equal(t, uint32(1), ok)
 }
 
+func TestController_BestScores(t *testing.T) {
+	ctx := context.Background()
+	url := "http://best-scores"
+
+	for i, score := range []*pb.SoloScore{
+		{Width: 11, Height: 11, Turns: 20, FoodEaten: 1},
+		{Width: 11, Height: 11, Turns: 20, FoodEaten: 4},
+		{Width: 11, Height: 11, Turns: 10, FoodEaten: 9},
+		{Width: 7, Height: 7, Turns: 5},
+	} {
+		id := fmt.Sprintf("best-scores-%d", i)
+		score.GameID = id
+		score.SnakeURL = url
+		require.NoError(t, store.CreateGame(ctx, &pb.Game{ID: id}, nil))
+		require.NoError(t, store.SetGameResult(ctx, id, &pb.GameResult{Score: score}))
+	}
+
+	resp, err := client.BestScores(ctx, &pb.BestScoresRequest{SnakeURL: url})
+	require.NoError(t, err)
+	require.Len(t, resp.Scores, 2)
+	require.Equal(t, "best-scores-3", resp.Scores[0].GameID)
+	require.Equal(t, "best-scores-1", resp.Scores[1].GameID)
+
+	resp, err = client.BestScores(ctx, &pb.BestScoresRequest{SnakeURL: url, Width: 11, Height: 11})
+	require.NoError(t, err)
+	require.Len(t, resp.Scores, 1)
+	require.Equal(t, "best-scores-1", resp.Scores[0].GameID)
+
+	_, err = client.BestScores(ctx, &pb.BestScoresRequest{})
+	require.Error(t, err)
+}
+
 func TestController_Ping(t *testing.T) {
 	ctx := context.Background()
 
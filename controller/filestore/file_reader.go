@@ -137,6 +137,34 @@ func readArchive(dir string, id string) (gameArchive, error) {
 	}, nil
 }
 
+// readScores loads all the solo scores stored in the scores file, there are
+// none when the file doesn't exist yet.
+func readScores(dir string) ([]*pb.SoloScore, error) {
+	r, err := openFileReader(dir, scoresID)
+	if os.IsNotExist(err) {
+		return []*pb.SoloScore{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = r.Close()
+		if err != nil {
+			log.WithError(err).Error("Error while closing reader")
+		}
+	}()
+
+	scores := []*pb.SoloScore{}
+	for more := true; more; {
+		s := &pb.SoloScore{}
+		more, err = readLine(r, s)
+		if err == nil {
+			scores = append(scores, s)
+		}
+	}
+	return scores, nil
+}
+
 // ReadGameFrames loads all the game frames stored in given file.
 func ReadGameFrames(dir string, id string) ([]*pb.GameFrame, error) {
 	archive, err := readArchive(dir, id)
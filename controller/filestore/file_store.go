@@ -192,6 +192,42 @@ func (fs *fileStore) SetGameStatus(ctx context.Context, id string, status rules.
 	return nil
 }
 
+func (fs *fileStore) SetGameResult(ctx context.Context, id string, result *pb.GameResult) error {
+	fs.lock.Lock()
+	defer fs.lock.Unlock()
+
+	game, err := fs.requireGame(id)
+	if err != nil {
+		return err
+	}
+
+	game.Result = result
+	if result.Score != nil {
+		return fs.appendScore(result.Score)
+	}
+	return nil
+}
+
+// ListSoloScores lists the scores of the single player games played by a
+// snake URL. Scores are kept in their own file since game files are only read
+// one at a time.
+func (fs *fileStore) ListSoloScores(ctx context.Context, snakeURL string) ([]*pb.SoloScore, error) {
+	fs.lock.Lock()
+	defer fs.lock.Unlock()
+
+	all, err := readScores(fs.directory)
+	if err != nil {
+		return nil, err
+	}
+	scores := []*pb.SoloScore{}
+	for _, s := range all {
+		if s.SnakeURL == snakeURL {
+			scores = append(scores, s)
+		}
+	}
+	return scores, nil
+}
+
 func (fs *fileStore) PushGameFrame(ctx context.Context, id string, g *pb.GameFrame) error {
 	fs.lock.Lock()
 	defer fs.lock.Unlock()
@@ -322,6 +358,19 @@ func (fs *fileStore) appendFrames(gameID string, frames []*pb.GameFrame) error {
 	return nil
 }
 
+func (fs *fileStore) appendScore(score *pb.SoloScore) error {
+	handle, err := openFileWriter(fs.directory, scoresID, false)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := handle.Close(); err != nil {
+			log.WithError(err).Error("Error while closing scores writer")
+		}
+	}()
+	return writeScore(handle, score)
+}
+
 func (fs *fileStore) hasAnyFrames(gameID string) bool {
 	frames, ok := fs.frames[gameID]
 	return ok && len(frames) > 0
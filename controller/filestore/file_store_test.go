@@ -111,6 +111,38 @@ func TestListGameFramesEmpty(t *testing.T) {
 	require.Nil(t, newFrames)
 }
 
+func TestSetGameResult(t *testing.T) {
+	fs, _ := testFileStore()
+	err := fs.CreateGame(context.Background(), basicGame(), []*pb.GameFrame{basicFrames()[0]})
+	require.NoError(t, err)
+
+	result := &pb.GameResult{Winners: []string{"snake"}, Reason: rules.ResultReasonEliminated}
+	err = fs.SetGameResult(context.Background(), "myid", result)
+	require.NoError(t, err)
+
+	game, err := fs.GetGame(context.Background(), "myid")
+	require.NoError(t, err)
+	require.Equal(t, result, game.Result)
+}
+
+func TestSetGameResultSoloScore(t *testing.T) {
+	fs, _ := testFileStore()
+	err := fs.CreateGame(context.Background(), basicGame(), []*pb.GameFrame{basicFrames()[0]})
+	require.NoError(t, err)
+
+	score := &pb.SoloScore{GameID: "myid", SnakeURL: "http://snake", Width: 11, Height: 11, Turns: 42}
+	err = fs.SetGameResult(context.Background(), "myid", &pb.GameResult{Score: score})
+	require.NoError(t, err)
+
+	scores, err := fs.ListSoloScores(context.Background(), "http://snake")
+	require.NoError(t, err)
+	require.Equal(t, []*pb.SoloScore{score}, scores)
+
+	scores, err = fs.ListSoloScores(context.Background(), "http://other")
+	require.NoError(t, err)
+	require.Empty(t, scores)
+}
+
 func TestSetGameStatusInvalidGame(t *testing.T) {
 	fs, _ := testFileStore()
 
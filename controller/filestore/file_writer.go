@@ -9,6 +9,9 @@ import (
 
 var openFileWriter = appendOnlyFileWriter
 
+// scoresID names the file solo scores are kept in.
+const scoresID = "scores"
+
 type writer interface {
 	WriteString(s string) (int, error)
 	Close() error
@@ -31,6 +34,10 @@ func writeFrame(w writer, f *pb.GameFrame) error {
 	return writeLine(w, f)
 }
 
+func writeScore(w writer, s *pb.SoloScore) error {
+	return writeLine(w, s)
+}
+
 func writeGameInfo(w writer, game *pb.Game, snakes []*pb.Snake) error {
 	return writeLine(w, game)
 }
@@ -24,16 +24,23 @@ It has these top-level messages:
 	AddGameFrameResponse
 	ListGameFramesRequest
 	ListGameFramesResponse
+	BestScoresRequest
+	BestScoresResponse
 	EndGameRequest
 	EndGameResponse
 	PingRequest
 	PingResponse
 	SnakeOptions
 	Game
+	Script
+	GameResult
+	SoloScore
 	GameFrame
+	FoodItem
 	Point
 	Snake
 	Death
+	MoveResult
 */
 package pb
 
@@ -289,12 +296,50 @@ func (*StartResponse) ProtoMessage()               {}
 func (*StartResponse) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{9} }
 
 type CreateRequest struct {
-	Width                   int32           `protobuf:"varint,1,opt,name=Width,proto3" json:"Width,omitempty"`
-	Height                  int32           `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"`
-	Food                    int32           `protobuf:"varint,3,opt,name=Food,proto3" json:"Food,omitempty"`
-	Snakes                  []*SnakeOptions `protobuf:"bytes,4,rep,name=Snakes" json:"Snakes,omitempty"`
-	MaxTurnsToNextFoodSpawn int32           `protobuf:"varint,5,opt,name=MaxTurnsToNextFoodSpawn,proto3" json:"MaxTurnsToNextFoodSpawn,omitempty"`
-	SnakeTimeout            int32           `protobuf:"varint,6,opt,name=SnakeTimeout,proto3" json:"SnakeTimeout,omitempty"`
+	Width                   int32            `protobuf:"varint,1,opt,name=Width,proto3" json:"Width,omitempty"`
+	Height                  int32            `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"`
+	Food                    int32            `protobuf:"varint,3,opt,name=Food,proto3" json:"Food,omitempty"`
+	Snakes                  []*SnakeOptions  `protobuf:"bytes,4,rep,name=Snakes" json:"Snakes,omitempty"`
+	MaxTurnsToNextFoodSpawn int32            `protobuf:"varint,5,opt,name=MaxTurnsToNextFoodSpawn,proto3" json:"MaxTurnsToNextFoodSpawn,omitempty"`
+	SnakeTimeout            int32            `protobuf:"varint,6,opt,name=SnakeTimeout,proto3" json:"SnakeTimeout,omitempty"`
+	Ruleset                 string           `protobuf:"bytes,7,opt,name=Ruleset,proto3" json:"Ruleset,omitempty"`
+	Seed                    int64            `protobuf:"varint,8,opt,name=Seed,proto3" json:"Seed,omitempty"`
+	Wrapped                 bool             `protobuf:"varint,9,opt,name=Wrapped,proto3" json:"Wrapped,omitempty"`
+	HazardDamage            int32            `protobuf:"varint,10,opt,name=HazardDamage,proto3" json:"HazardDamage,omitempty"`
+	ShrinkEveryNTurns       int32            `protobuf:"varint,11,opt,name=ShrinkEveryNTurns,proto3" json:"ShrinkEveryNTurns,omitempty"`
+	AllowBodyCollisions     bool             `protobuf:"varint,12,opt,name=AllowBodyCollisions,proto3" json:"AllowBodyCollisions,omitempty"`
+	SharedElimination       bool             `protobuf:"varint,13,opt,name=SharedElimination,proto3" json:"SharedElimination,omitempty"`
+	SharedHealth            bool             `protobuf:"varint,14,opt,name=SharedHealth,proto3" json:"SharedHealth,omitempty"`
+	SharedLength            bool             `protobuf:"varint,15,opt,name=SharedLength,proto3" json:"SharedLength,omitempty"`
+	StartingHealth          int32            `protobuf:"varint,16,opt,name=StartingHealth,proto3" json:"StartingHealth,omitempty"`
+	FoodHealth              int32            `protobuf:"varint,17,opt,name=FoodHealth,proto3" json:"FoodHealth,omitempty"`
+	HealthLossPerTurn       int32            `protobuf:"varint,18,opt,name=HealthLossPerTurn,proto3" json:"HealthLossPerTurn,omitempty"`
+	StartingLength          int32            `protobuf:"varint,19,opt,name=StartingLength,proto3" json:"StartingLength,omitempty"`
+	MaxTurns                int32            `protobuf:"varint,20,opt,name=MaxTurns,proto3" json:"MaxTurns,omitempty"`
+	Tiebreaker              string           `protobuf:"bytes,21,opt,name=Tiebreaker,proto3" json:"Tiebreaker,omitempty"`
+	Map                     string           `protobuf:"bytes,22,opt,name=Map,proto3" json:"Map,omitempty"`
+	MapGenerator            string           `protobuf:"bytes,23,opt,name=MapGenerator,proto3" json:"MapGenerator,omitempty"`
+	FoodSpawner             string           `protobuf:"bytes,24,opt,name=FoodSpawner,proto3" json:"FoodSpawner,omitempty"`
+	MinimumFood             int32            `protobuf:"varint,25,opt,name=MinimumFood,proto3" json:"MinimumFood,omitempty"`
+	FoodSpawnInterval       int32            `protobuf:"varint,26,opt,name=FoodSpawnInterval,proto3" json:"FoodSpawnInterval,omitempty"`
+	FoodPlacement           string           `protobuf:"bytes,27,opt,name=FoodPlacement,proto3" json:"FoodPlacement,omitempty"`
+	FoodMinHeadDistance     int32            `protobuf:"varint,28,opt,name=FoodMinHeadDistance,proto3" json:"FoodMinHeadDistance,omitempty"`
+	FoodWeights             map[string]int32 `protobuf:"bytes,29,rep,name=FoodWeights" json:"FoodWeights,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	GrowthPerFood           int32            `protobuf:"varint,30,opt,name=GrowthPerFood,proto3" json:"GrowthPerFood,omitempty"`
+	HungryHealth            int32            `protobuf:"varint,31,opt,name=HungryHealth,proto3" json:"HungryHealth,omitempty"`
+	MinimumLength           int32            `protobuf:"varint,32,opt,name=MinimumLength,proto3" json:"MinimumLength,omitempty"`
+	MoveMode                string           `protobuf:"bytes,33,opt,name=MoveMode,proto3" json:"MoveMode,omitempty"`
+	VisionRadius            int32            `protobuf:"varint,34,opt,name=VisionRadius,proto3" json:"VisionRadius,omitempty"`
+	VisionMetric            string           `protobuf:"bytes,35,opt,name=VisionMetric,proto3" json:"VisionMetric,omitempty"`
+	TimeBank                int32            `protobuf:"varint,36,opt,name=TimeBank,proto3" json:"TimeBank,omitempty"`
+	TimeIncrement           int32            `protobuf:"varint,37,opt,name=TimeIncrement,proto3" json:"TimeIncrement,omitempty"`
+	FailurePolicy           string           `protobuf:"bytes,38,opt,name=FailurePolicy,proto3" json:"FailurePolicy,omitempty"`
+	MaxFailures             int32            `protobuf:"varint,39,opt,name=MaxFailures,proto3" json:"MaxFailures,omitempty"`
+	Scenario                string           `protobuf:"bytes,40,opt,name=Scenario,proto3" json:"Scenario,omitempty"`
+	Mode                    string           `protobuf:"bytes,41,opt,name=Mode,proto3" json:"Mode,omitempty"`
+	EndCondition            string           `protobuf:"bytes,42,opt,name=EndCondition,proto3" json:"EndCondition,omitempty"`
+	SurvivorCount           int32            `protobuf:"varint,43,opt,name=SurvivorCount,proto3" json:"SurvivorCount,omitempty"`
+	TargetLength            int32            `protobuf:"varint,44,opt,name=TargetLength,proto3" json:"TargetLength,omitempty"`
 }
 
 func (m *CreateRequest) Reset()                    { *m = CreateRequest{} }
@@ -344,6 +389,272 @@ func (m *CreateRequest) GetSnakeTimeout() int32 {
 	return 0
 }
 
+func (m *CreateRequest) GetRuleset() string {
+	if m != nil {
+		return m.Ruleset
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetSeed() int64 {
+	if m != nil {
+		return m.Seed
+	}
+	return 0
+}
+
+func (m *CreateRequest) GetWrapped() bool {
+	if m != nil {
+		return m.Wrapped
+	}
+	return false
+}
+
+func (m *CreateRequest) GetHazardDamage() int32 {
+	if m != nil {
+		return m.HazardDamage
+	}
+	return 0
+}
+
+func (m *CreateRequest) GetShrinkEveryNTurns() int32 {
+	if m != nil {
+		return m.ShrinkEveryNTurns
+	}
+	return 0
+}
+
+func (m *CreateRequest) GetAllowBodyCollisions() bool {
+	if m != nil {
+		return m.AllowBodyCollisions
+	}
+	return false
+}
+
+func (m *CreateRequest) GetSharedElimination() bool {
+	if m != nil {
+		return m.SharedElimination
+	}
+	return false
+}
+
+func (m *CreateRequest) GetSharedHealth() bool {
+	if m != nil {
+		return m.SharedHealth
+	}
+	return false
+}
+
+func (m *CreateRequest) GetSharedLength() bool {
+	if m != nil {
+		return m.SharedLength
+	}
+	return false
+}
+
+func (m *CreateRequest) GetStartingHealth() int32 {
+	if m != nil {
+		return m.StartingHealth
+	}
+	return 0
+}
+
+func (m *CreateRequest) GetFoodHealth() int32 {
+	if m != nil {
+		return m.FoodHealth
+	}
+	return 0
+}
+
+func (m *CreateRequest) GetHealthLossPerTurn() int32 {
+	if m != nil {
+		return m.HealthLossPerTurn
+	}
+	return 0
+}
+
+func (m *CreateRequest) GetStartingLength() int32 {
+	if m != nil {
+		return m.StartingLength
+	}
+	return 0
+}
+
+func (m *CreateRequest) GetMaxTurns() int32 {
+	if m != nil {
+		return m.MaxTurns
+	}
+	return 0
+}
+
+func (m *CreateRequest) GetTiebreaker() string {
+	if m != nil {
+		return m.Tiebreaker
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetMap() string {
+	if m != nil {
+		return m.Map
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetMapGenerator() string {
+	if m != nil {
+		return m.MapGenerator
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetFoodSpawner() string {
+	if m != nil {
+		return m.FoodSpawner
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetMinimumFood() int32 {
+	if m != nil {
+		return m.MinimumFood
+	}
+	return 0
+}
+
+func (m *CreateRequest) GetFoodSpawnInterval() int32 {
+	if m != nil {
+		return m.FoodSpawnInterval
+	}
+	return 0
+}
+
+func (m *CreateRequest) GetFoodPlacement() string {
+	if m != nil {
+		return m.FoodPlacement
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetFoodMinHeadDistance() int32 {
+	if m != nil {
+		return m.FoodMinHeadDistance
+	}
+	return 0
+}
+
+func (m *CreateRequest) GetFoodWeights() map[string]int32 {
+	if m != nil {
+		return m.FoodWeights
+	}
+	return nil
+}
+
+func (m *CreateRequest) GetGrowthPerFood() int32 {
+	if m != nil {
+		return m.GrowthPerFood
+	}
+	return 0
+}
+
+func (m *CreateRequest) GetHungryHealth() int32 {
+	if m != nil {
+		return m.HungryHealth
+	}
+	return 0
+}
+
+func (m *CreateRequest) GetMinimumLength() int32 {
+	if m != nil {
+		return m.MinimumLength
+	}
+	return 0
+}
+
+func (m *CreateRequest) GetMoveMode() string {
+	if m != nil {
+		return m.MoveMode
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetVisionRadius() int32 {
+	if m != nil {
+		return m.VisionRadius
+	}
+	return 0
+}
+
+func (m *CreateRequest) GetVisionMetric() string {
+	if m != nil {
+		return m.VisionMetric
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetTimeBank() int32 {
+	if m != nil {
+		return m.TimeBank
+	}
+	return 0
+}
+
+func (m *CreateRequest) GetTimeIncrement() int32 {
+	if m != nil {
+		return m.TimeIncrement
+	}
+	return 0
+}
+
+func (m *CreateRequest) GetFailurePolicy() string {
+	if m != nil {
+		return m.FailurePolicy
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetMaxFailures() int32 {
+	if m != nil {
+		return m.MaxFailures
+	}
+	return 0
+}
+
+func (m *CreateRequest) GetScenario() string {
+	if m != nil {
+		return m.Scenario
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetEndCondition() string {
+	if m != nil {
+		return m.EndCondition
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetSurvivorCount() int32 {
+	if m != nil {
+		return m.SurvivorCount
+	}
+	return 0
+}
+
+func (m *CreateRequest) GetTargetLength() int32 {
+	if m != nil {
+		return m.TargetLength
+	}
+	return 0
+}
+
 type CreateResponse struct {
 	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
 }
@@ -458,14 +769,63 @@ func (m *ListGameFramesResponse) GetCount() int32 {
 	return 0
 }
 
+type BestScoresRequest struct {
+	SnakeURL string `protobuf:"bytes,1,opt,name=SnakeURL,proto3" json:"SnakeURL,omitempty"`
+	Width    int32  `protobuf:"varint,2,opt,name=Width,proto3" json:"Width,omitempty"`
+	Height   int32  `protobuf:"varint,3,opt,name=Height,proto3" json:"Height,omitempty"`
+}
+
+func (m *BestScoresRequest) Reset()                    { *m = BestScoresRequest{} }
+func (m *BestScoresRequest) String() string            { return proto.CompactTextString(m) }
+func (*BestScoresRequest) ProtoMessage()               {}
+func (*BestScoresRequest) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{16} }
+
+func (m *BestScoresRequest) GetSnakeURL() string {
+	if m != nil {
+		return m.SnakeURL
+	}
+	return ""
+}
+
+func (m *BestScoresRequest) GetWidth() int32 {
+	if m != nil {
+		return m.Width
+	}
+	return 0
+}
+
+func (m *BestScoresRequest) GetHeight() int32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type BestScoresResponse struct {
+	Scores []*SoloScore `protobuf:"bytes,1,rep,name=Scores" json:"Scores,omitempty"`
+}
+
+func (m *BestScoresResponse) Reset()                    { *m = BestScoresResponse{} }
+func (m *BestScoresResponse) String() string            { return proto.CompactTextString(m) }
+func (*BestScoresResponse) ProtoMessage()               {}
+func (*BestScoresResponse) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{17} }
+
+func (m *BestScoresResponse) GetScores() []*SoloScore {
+	if m != nil {
+		return m.Scores
+	}
+	return nil
+}
+
 type EndGameRequest struct {
-	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	ID     string      `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Result *GameResult `protobuf:"bytes,2,opt,name=Result" json:"Result,omitempty"`
 }
 
 func (m *EndGameRequest) Reset()                    { *m = EndGameRequest{} }
 func (m *EndGameRequest) String() string            { return proto.CompactTextString(m) }
 func (*EndGameRequest) ProtoMessage()               {}
-func (*EndGameRequest) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{16} }
+func (*EndGameRequest) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{18} }
 
 func (m *EndGameRequest) GetID() string {
 	if m != nil {
@@ -474,13 +834,20 @@ func (m *EndGameRequest) GetID() string {
 	return ""
 }
 
+func (m *EndGameRequest) GetResult() *GameResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
 type EndGameResponse struct {
 }
 
 func (m *EndGameResponse) Reset()                    { *m = EndGameResponse{} }
 func (m *EndGameResponse) String() string            { return proto.CompactTextString(m) }
 func (*EndGameResponse) ProtoMessage()               {}
-func (*EndGameResponse) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{17} }
+func (*EndGameResponse) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{19} }
 
 type PingRequest struct {
 }
@@ -488,7 +855,7 @@ type PingRequest struct {
 func (m *PingRequest) Reset()                    { *m = PingRequest{} }
 func (m *PingRequest) String() string            { return proto.CompactTextString(m) }
 func (*PingRequest) ProtoMessage()               {}
-func (*PingRequest) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{18} }
+func (*PingRequest) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{20} }
 
 type PingResponse struct {
 	Version string `protobuf:"bytes,1,opt,name=Version,proto3" json:"Version,omitempty"`
@@ -497,7 +864,7 @@ type PingResponse struct {
 func (m *PingResponse) Reset()                    { *m = PingResponse{} }
 func (m *PingResponse) String() string            { return proto.CompactTextString(m) }
 func (*PingResponse) ProtoMessage()               {}
-func (*PingResponse) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{19} }
+func (*PingResponse) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{21} }
 
 func (m *PingResponse) GetVersion() string {
 	if m != nil {
@@ -512,12 +879,13 @@ type SnakeOptions struct {
 	ID       string `protobuf:"bytes,3,opt,name=ID,proto3" json:"ID,omitempty"`
 	HeadType string `protobuf:"bytes,4,opt,name=HeadType,proto3" json:"HeadType,omitempty"`
 	TailType string `protobuf:"bytes,5,opt,name=TailType,proto3" json:"TailType,omitempty"`
+	Squad    string `protobuf:"bytes,6,opt,name=Squad,proto3" json:"Squad,omitempty"`
 }
 
 func (m *SnakeOptions) Reset()                    { *m = SnakeOptions{} }
 func (m *SnakeOptions) String() string            { return proto.CompactTextString(m) }
 func (*SnakeOptions) ProtoMessage()               {}
-func (*SnakeOptions) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{20} }
+func (*SnakeOptions) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{22} }
 
 func (m *SnakeOptions) GetName() string {
 	if m != nil {
@@ -554,21 +922,69 @@ func (m *SnakeOptions) GetTailType() string {
 	return ""
 }
 
+func (m *SnakeOptions) GetSquad() string {
+	if m != nil {
+		return m.Squad
+	}
+	return ""
+}
+
 type Game struct {
-	ID                      string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Status                  string `protobuf:"bytes,2,opt,name=Status,proto3" json:"Status,omitempty"`
-	Width                   int32  `protobuf:"varint,3,opt,name=Width,proto3" json:"Width,omitempty"`
-	Height                  int32  `protobuf:"varint,4,opt,name=Height,proto3" json:"Height,omitempty"`
-	SnakeTimeout            int32  `protobuf:"varint,6,opt,name=SnakeTimeout,proto3" json:"SnakeTimeout,omitempty"`
-	Mode                    string `protobuf:"bytes,8,opt,name=Mode,proto3" json:"Mode,omitempty"`
-	MaxTurnsToNextFoodSpawn int32  `protobuf:"varint,9,opt,name=MaxTurnsToNextFoodSpawn,proto3" json:"MaxTurnsToNextFoodSpawn,omitempty"`
-	TurnsSinceLastFoodSpawn int32  `protobuf:"varint,10,opt,name=TurnsSinceLastFoodSpawn,proto3" json:"TurnsSinceLastFoodSpawn,omitempty"`
+	ID                      string             `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Status                  string             `protobuf:"bytes,2,opt,name=Status,proto3" json:"Status,omitempty"`
+	Width                   int32              `protobuf:"varint,3,opt,name=Width,proto3" json:"Width,omitempty"`
+	Height                  int32              `protobuf:"varint,4,opt,name=Height,proto3" json:"Height,omitempty"`
+	SnakeTimeout            int32              `protobuf:"varint,6,opt,name=SnakeTimeout,proto3" json:"SnakeTimeout,omitempty"`
+	Mode                    string             `protobuf:"bytes,8,opt,name=Mode,proto3" json:"Mode,omitempty"`
+	MaxTurnsToNextFoodSpawn int32              `protobuf:"varint,9,opt,name=MaxTurnsToNextFoodSpawn,proto3" json:"MaxTurnsToNextFoodSpawn,omitempty"`
+	TurnsSinceLastFoodSpawn int32              `protobuf:"varint,10,opt,name=TurnsSinceLastFoodSpawn,proto3" json:"TurnsSinceLastFoodSpawn,omitempty"`
+	Ruleset                 string             `protobuf:"bytes,11,opt,name=Ruleset,proto3" json:"Ruleset,omitempty"`
+	Seed                    int64              `protobuf:"varint,12,opt,name=Seed,proto3" json:"Seed,omitempty"`
+	Wrapped                 bool               `protobuf:"varint,13,opt,name=Wrapped,proto3" json:"Wrapped,omitempty"`
+	HazardDamage            int32              `protobuf:"varint,14,opt,name=HazardDamage,proto3" json:"HazardDamage,omitempty"`
+	ShrinkEveryNTurns       int32              `protobuf:"varint,15,opt,name=ShrinkEveryNTurns,proto3" json:"ShrinkEveryNTurns,omitempty"`
+	AllowBodyCollisions     bool               `protobuf:"varint,16,opt,name=AllowBodyCollisions,proto3" json:"AllowBodyCollisions,omitempty"`
+	SharedElimination       bool               `protobuf:"varint,17,opt,name=SharedElimination,proto3" json:"SharedElimination,omitempty"`
+	SharedHealth            bool               `protobuf:"varint,18,opt,name=SharedHealth,proto3" json:"SharedHealth,omitempty"`
+	SharedLength            bool               `protobuf:"varint,19,opt,name=SharedLength,proto3" json:"SharedLength,omitempty"`
+	StartingHealth          int32              `protobuf:"varint,20,opt,name=StartingHealth,proto3" json:"StartingHealth,omitempty"`
+	FoodHealth              int32              `protobuf:"varint,21,opt,name=FoodHealth,proto3" json:"FoodHealth,omitempty"`
+	HealthLossPerTurn       int32              `protobuf:"varint,22,opt,name=HealthLossPerTurn,proto3" json:"HealthLossPerTurn,omitempty"`
+	StartingLength          int32              `protobuf:"varint,23,opt,name=StartingLength,proto3" json:"StartingLength,omitempty"`
+	MaxTurns                int32              `protobuf:"varint,24,opt,name=MaxTurns,proto3" json:"MaxTurns,omitempty"`
+	Tiebreaker              string             `protobuf:"bytes,25,opt,name=Tiebreaker,proto3" json:"Tiebreaker,omitempty"`
+	Result                  *GameResult        `protobuf:"bytes,26,opt,name=Result" json:"Result,omitempty"`
+	Map                     string             `protobuf:"bytes,27,opt,name=Map,proto3" json:"Map,omitempty"`
+	FoodSpawns              []*Point           `protobuf:"bytes,28,rep,name=FoodSpawns" json:"FoodSpawns,omitempty"`
+	FoodSpawner             string             `protobuf:"bytes,29,opt,name=FoodSpawner,proto3" json:"FoodSpawner,omitempty"`
+	MinimumFood             int32              `protobuf:"varint,30,opt,name=MinimumFood,proto3" json:"MinimumFood,omitempty"`
+	FoodSpawnInterval       int32              `protobuf:"varint,31,opt,name=FoodSpawnInterval,proto3" json:"FoodSpawnInterval,omitempty"`
+	FoodPlacement           string             `protobuf:"bytes,32,opt,name=FoodPlacement,proto3" json:"FoodPlacement,omitempty"`
+	FoodMinHeadDistance     int32              `protobuf:"varint,33,opt,name=FoodMinHeadDistance,proto3" json:"FoodMinHeadDistance,omitempty"`
+	FoodWeights             map[string]int32   `protobuf:"bytes,34,rep,name=FoodWeights" json:"FoodWeights,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	GrowthPerFood           int32              `protobuf:"varint,35,opt,name=GrowthPerFood,proto3" json:"GrowthPerFood,omitempty"`
+	HungryHealth            int32              `protobuf:"varint,36,opt,name=HungryHealth,proto3" json:"HungryHealth,omitempty"`
+	MinimumLength           int32              `protobuf:"varint,37,opt,name=MinimumLength,proto3" json:"MinimumLength,omitempty"`
+	MoveMode                string             `protobuf:"bytes,38,opt,name=MoveMode,proto3" json:"MoveMode,omitempty"`
+	VisionRadius            int32              `protobuf:"varint,39,opt,name=VisionRadius,proto3" json:"VisionRadius,omitempty"`
+	VisionMetric            string             `protobuf:"bytes,40,opt,name=VisionMetric,proto3" json:"VisionMetric,omitempty"`
+	TimeBank                int32              `protobuf:"varint,41,opt,name=TimeBank,proto3" json:"TimeBank,omitempty"`
+	TimeIncrement           int32              `protobuf:"varint,42,opt,name=TimeIncrement,proto3" json:"TimeIncrement,omitempty"`
+	FailurePolicy           string             `protobuf:"bytes,43,opt,name=FailurePolicy,proto3" json:"FailurePolicy,omitempty"`
+	MaxFailures             int32              `protobuf:"varint,44,opt,name=MaxFailures,proto3" json:"MaxFailures,omitempty"`
+	Scenario                string             `protobuf:"bytes,45,opt,name=Scenario,proto3" json:"Scenario,omitempty"`
+	Goal                    string             `protobuf:"bytes,46,opt,name=Goal,proto3" json:"Goal,omitempty"`
+	GoalTurns               int32              `protobuf:"varint,47,opt,name=GoalTurns,proto3" json:"GoalTurns,omitempty"`
+	EndCondition            string             `protobuf:"bytes,48,opt,name=EndCondition,proto3" json:"EndCondition,omitempty"`
+	SurvivorCount           int32              `protobuf:"varint,49,opt,name=SurvivorCount,proto3" json:"SurvivorCount,omitempty"`
+	TargetLength            int32              `protobuf:"varint,50,opt,name=TargetLength,proto3" json:"TargetLength,omitempty"`
+	Scripts                 map[string]*Script `protobuf:"bytes,51,rep,name=Scripts" json:"Scripts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *Game) Reset()                    { *m = Game{} }
 func (m *Game) String() string            { return proto.CompactTextString(m) }
 func (*Game) ProtoMessage()               {}
-func (*Game) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{21} }
+func (*Game) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{23} }
 
 func (m *Game) GetID() string {
 	if m != nil {
@@ -626,210 +1042,1243 @@ func (m *Game) GetTurnsSinceLastFoodSpawn() int32 {
 	return 0
 }
 
-type GameFrame struct {
-	Turn   int32    `protobuf:"varint,1,opt,name=Turn,proto3" json:"Turn,omitempty"`
-	Food   []*Point `protobuf:"bytes,2,rep,name=Food" json:"Food,omitempty"`
-	Snakes []*Snake `protobuf:"bytes,3,rep,name=Snakes" json:"Snakes,omitempty"`
+func (m *Game) GetRuleset() string {
+	if m != nil {
+		return m.Ruleset
+	}
+	return ""
 }
 
-func (m *GameFrame) Reset()                    { *m = GameFrame{} }
-func (m *GameFrame) String() string            { return proto.CompactTextString(m) }
-func (*GameFrame) ProtoMessage()               {}
-func (*GameFrame) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{22} }
-
-func (m *GameFrame) GetTurn() int32 {
+func (m *Game) GetSeed() int64 {
 	if m != nil {
-		return m.Turn
+		return m.Seed
 	}
 	return 0
 }
 
-func (m *GameFrame) GetFood() []*Point {
+func (m *Game) GetWrapped() bool {
 	if m != nil {
-		return m.Food
+		return m.Wrapped
 	}
-	return nil
+	return false
 }
 
-func (m *GameFrame) GetSnakes() []*Snake {
+func (m *Game) GetHazardDamage() int32 {
 	if m != nil {
-		return m.Snakes
+		return m.HazardDamage
 	}
-	return nil
-}
-
-type Point struct {
-	X int32 `protobuf:"varint,1,opt,name=X,proto3" json:"X,omitempty"`
-	Y int32 `protobuf:"varint,2,opt,name=Y,proto3" json:"Y,omitempty"`
+	return 0
 }
 
-func (m *Point) Reset()                    { *m = Point{} }
-func (m *Point) String() string            { return proto.CompactTextString(m) }
-func (*Point) ProtoMessage()               {}
-func (*Point) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{23} }
-
-func (m *Point) GetX() int32 {
+func (m *Game) GetShrinkEveryNTurns() int32 {
 	if m != nil {
-		return m.X
+		return m.ShrinkEveryNTurns
 	}
 	return 0
 }
 
-func (m *Point) GetY() int32 {
+func (m *Game) GetAllowBodyCollisions() bool {
 	if m != nil {
-		return m.Y
+		return m.AllowBodyCollisions
 	}
-	return 0
+	return false
 }
 
-type Snake struct {
-	ID       string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Name     string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	URL      string   `protobuf:"bytes,3,opt,name=URL,proto3" json:"URL,omitempty"`
-	Body     []*Point `protobuf:"bytes,4,rep,name=Body" json:"Body,omitempty"`
-	Health   int32    `protobuf:"varint,5,opt,name=Health,proto3" json:"Health,omitempty"`
-	Death    *Death   `protobuf:"bytes,6,opt,name=Death" json:"Death,omitempty"`
-	Color    string   `protobuf:"bytes,7,opt,name=Color,proto3" json:"Color,omitempty"`
-	HeadType string   `protobuf:"bytes,8,opt,name=HeadType,proto3" json:"HeadType,omitempty"`
-	TailType string   `protobuf:"bytes,9,opt,name=TailType,proto3" json:"TailType,omitempty"`
-	Latency  string   `protobuf:"bytes,10,opt,name=Latency,proto3" json:"Latency,omitempty"`
+func (m *Game) GetSharedElimination() bool {
+	if m != nil {
+		return m.SharedElimination
+	}
+	return false
 }
 
-func (m *Snake) Reset()                    { *m = Snake{} }
-func (m *Snake) String() string            { return proto.CompactTextString(m) }
-func (*Snake) ProtoMessage()               {}
-func (*Snake) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{24} }
+func (m *Game) GetSharedHealth() bool {
+	if m != nil {
+		return m.SharedHealth
+	}
+	return false
+}
 
-func (m *Snake) GetID() string {
+func (m *Game) GetSharedLength() bool {
 	if m != nil {
-		return m.ID
+		return m.SharedLength
 	}
-	return ""
+	return false
 }
 
-func (m *Snake) GetName() string {
+func (m *Game) GetStartingHealth() int32 {
 	if m != nil {
-		return m.Name
+		return m.StartingHealth
+	}
+	return 0
+}
+
+func (m *Game) GetFoodHealth() int32 {
+	if m != nil {
+		return m.FoodHealth
+	}
+	return 0
+}
+
+func (m *Game) GetHealthLossPerTurn() int32 {
+	if m != nil {
+		return m.HealthLossPerTurn
+	}
+	return 0
+}
+
+func (m *Game) GetStartingLength() int32 {
+	if m != nil {
+		return m.StartingLength
+	}
+	return 0
+}
+
+func (m *Game) GetMaxTurns() int32 {
+	if m != nil {
+		return m.MaxTurns
+	}
+	return 0
+}
+
+func (m *Game) GetTiebreaker() string {
+	if m != nil {
+		return m.Tiebreaker
 	}
 	return ""
 }
 
-func (m *Snake) GetURL() string {
+func (m *Game) GetResult() *GameResult {
 	if m != nil {
-		return m.URL
+		return m.Result
+	}
+	return nil
+}
+
+func (m *Game) GetMap() string {
+	if m != nil {
+		return m.Map
 	}
 	return ""
 }
 
-func (m *Snake) GetBody() []*Point {
+func (m *Game) GetFoodSpawns() []*Point {
 	if m != nil {
-		return m.Body
+		return m.FoodSpawns
 	}
 	return nil
 }
 
-func (m *Snake) GetHealth() int32 {
+func (m *Game) GetFoodSpawner() string {
 	if m != nil {
-		return m.Health
+		return m.FoodSpawner
+	}
+	return ""
+}
+
+func (m *Game) GetMinimumFood() int32 {
+	if m != nil {
+		return m.MinimumFood
 	}
 	return 0
 }
 
-func (m *Snake) GetDeath() *Death {
+func (m *Game) GetFoodSpawnInterval() int32 {
 	if m != nil {
-		return m.Death
+		return m.FoodSpawnInterval
+	}
+	return 0
+}
+
+func (m *Game) GetFoodPlacement() string {
+	if m != nil {
+		return m.FoodPlacement
+	}
+	return ""
+}
+
+func (m *Game) GetFoodMinHeadDistance() int32 {
+	if m != nil {
+		return m.FoodMinHeadDistance
+	}
+	return 0
+}
+
+func (m *Game) GetFoodWeights() map[string]int32 {
+	if m != nil {
+		return m.FoodWeights
 	}
 	return nil
 }
 
-func (m *Snake) GetColor() string {
+func (m *Game) GetGrowthPerFood() int32 {
 	if m != nil {
-		return m.Color
+		return m.GrowthPerFood
+	}
+	return 0
+}
+
+func (m *Game) GetHungryHealth() int32 {
+	if m != nil {
+		return m.HungryHealth
+	}
+	return 0
+}
+
+func (m *Game) GetMinimumLength() int32 {
+	if m != nil {
+		return m.MinimumLength
+	}
+	return 0
+}
+
+func (m *Game) GetMoveMode() string {
+	if m != nil {
+		return m.MoveMode
 	}
 	return ""
 }
 
-func (m *Snake) GetHeadType() string {
+func (m *Game) GetVisionRadius() int32 {
 	if m != nil {
-		return m.HeadType
+		return m.VisionRadius
+	}
+	return 0
+}
+
+func (m *Game) GetVisionMetric() string {
+	if m != nil {
+		return m.VisionMetric
 	}
 	return ""
 }
 
-func (m *Snake) GetTailType() string {
+func (m *Game) GetTimeBank() int32 {
 	if m != nil {
-		return m.TailType
+		return m.TimeBank
+	}
+	return 0
+}
+
+func (m *Game) GetTimeIncrement() int32 {
+	if m != nil {
+		return m.TimeIncrement
+	}
+	return 0
+}
+
+func (m *Game) GetFailurePolicy() string {
+	if m != nil {
+		return m.FailurePolicy
 	}
 	return ""
 }
 
-func (m *Snake) GetLatency() string {
+func (m *Game) GetMaxFailures() int32 {
 	if m != nil {
-		return m.Latency
+		return m.MaxFailures
+	}
+	return 0
+}
+
+func (m *Game) GetScenario() string {
+	if m != nil {
+		return m.Scenario
 	}
 	return ""
 }
 
-type Death struct {
-	Cause string `protobuf:"bytes,1,opt,name=Cause,proto3" json:"Cause,omitempty"`
-	Turn  int32  `protobuf:"varint,2,opt,name=Turn,proto3" json:"Turn,omitempty"`
+func (m *Game) GetGoal() string {
+	if m != nil {
+		return m.Goal
+	}
+	return ""
 }
 
-func (m *Death) Reset()                    { *m = Death{} }
-func (m *Death) String() string            { return proto.CompactTextString(m) }
-func (*Death) ProtoMessage()               {}
-func (*Death) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{25} }
+func (m *Game) GetGoalTurns() int32 {
+	if m != nil {
+		return m.GoalTurns
+	}
+	return 0
+}
 
-func (m *Death) GetCause() string {
+func (m *Game) GetEndCondition() string {
 	if m != nil {
-		return m.Cause
+		return m.EndCondition
 	}
 	return ""
 }
 
-func (m *Death) GetTurn() int32 {
+func (m *Game) GetSurvivorCount() int32 {
+	if m != nil {
+		return m.SurvivorCount
+	}
+	return 0
+}
+
+func (m *Game) GetTargetLength() int32 {
+	if m != nil {
+		return m.TargetLength
+	}
+	return 0
+}
+
+func (m *Game) GetScripts() map[string]*Script {
+	if m != nil {
+		return m.Scripts
+	}
+	return nil
+}
+
+type Script struct {
+	Moves []string `protobuf:"bytes,1,rep,name=Moves" json:"Moves,omitempty"`
+}
+
+func (m *Script) Reset()                    { *m = Script{} }
+func (m *Script) String() string            { return proto.CompactTextString(m) }
+func (*Script) ProtoMessage()               {}
+func (*Script) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{24} }
+
+func (m *Script) GetMoves() []string {
+	if m != nil {
+		return m.Moves
+	}
+	return nil
+}
+
+type GameResult struct {
+	Winners []string   `protobuf:"bytes,1,rep,name=Winners" json:"Winners,omitempty"`
+	Draw    bool       `protobuf:"varint,2,opt,name=Draw,proto3" json:"Draw,omitempty"`
+	Reason  string     `protobuf:"bytes,3,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	Score   *SoloScore `protobuf:"bytes,4,opt,name=Score" json:"Score,omitempty"`
+	Ranking []string   `protobuf:"bytes,5,rep,name=Ranking" json:"Ranking,omitempty"`
+}
+
+func (m *GameResult) Reset()                    { *m = GameResult{} }
+func (m *GameResult) String() string            { return proto.CompactTextString(m) }
+func (*GameResult) ProtoMessage()               {}
+func (*GameResult) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{25} }
+
+func (m *GameResult) GetWinners() []string {
+	if m != nil {
+		return m.Winners
+	}
+	return nil
+}
+
+func (m *GameResult) GetDraw() bool {
+	if m != nil {
+		return m.Draw
+	}
+	return false
+}
+
+func (m *GameResult) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *GameResult) GetScore() *SoloScore {
+	if m != nil {
+		return m.Score
+	}
+	return nil
+}
+
+func (m *GameResult) GetRanking() []string {
+	if m != nil {
+		return m.Ranking
+	}
+	return nil
+}
+
+type SoloScore struct {
+	GameID    string `protobuf:"bytes,1,opt,name=GameID,proto3" json:"GameID,omitempty"`
+	SnakeURL  string `protobuf:"bytes,2,opt,name=SnakeURL,proto3" json:"SnakeURL,omitempty"`
+	Width     int32  `protobuf:"varint,3,opt,name=Width,proto3" json:"Width,omitempty"`
+	Height    int32  `protobuf:"varint,4,opt,name=Height,proto3" json:"Height,omitempty"`
+	Turns     int32  `protobuf:"varint,5,opt,name=Turns,proto3" json:"Turns,omitempty"`
+	FoodEaten int32  `protobuf:"varint,6,opt,name=FoodEaten,proto3" json:"FoodEaten,omitempty"`
+	Length    int32  `protobuf:"varint,7,opt,name=Length,proto3" json:"Length,omitempty"`
+}
+
+func (m *SoloScore) Reset()                    { *m = SoloScore{} }
+func (m *SoloScore) String() string            { return proto.CompactTextString(m) }
+func (*SoloScore) ProtoMessage()               {}
+func (*SoloScore) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{26} }
+
+func (m *SoloScore) GetGameID() string {
+	if m != nil {
+		return m.GameID
+	}
+	return ""
+}
+
+func (m *SoloScore) GetSnakeURL() string {
+	if m != nil {
+		return m.SnakeURL
+	}
+	return ""
+}
+
+func (m *SoloScore) GetWidth() int32 {
+	if m != nil {
+		return m.Width
+	}
+	return 0
+}
+
+func (m *SoloScore) GetHeight() int32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SoloScore) GetTurns() int32 {
+	if m != nil {
+		return m.Turns
+	}
+	return 0
+}
+
+func (m *SoloScore) GetFoodEaten() int32 {
+	if m != nil {
+		return m.FoodEaten
+	}
+	return 0
+}
+
+func (m *SoloScore) GetLength() int32 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+type GameFrame struct {
+	Turn                    int32       `protobuf:"varint,1,opt,name=Turn,proto3" json:"Turn,omitempty"`
+	Food                    []*Point    `protobuf:"bytes,2,rep,name=Food" json:"Food,omitempty"`
+	Snakes                  []*Snake    `protobuf:"bytes,3,rep,name=Snakes" json:"Snakes,omitempty"`
+	TurnsSinceLastFoodSpawn int32       `protobuf:"varint,4,opt,name=TurnsSinceLastFoodSpawn,proto3" json:"TurnsSinceLastFoodSpawn,omitempty"`
+	Hazards                 []*Point    `protobuf:"bytes,5,rep,name=Hazards" json:"Hazards,omitempty"`
+	Walls                   []*Point    `protobuf:"bytes,6,rep,name=Walls" json:"Walls,omitempty"`
+	FoodItems               []*FoodItem `protobuf:"bytes,7,rep,name=FoodItems" json:"FoodItems,omitempty"`
+	MoveOrder               []string    `protobuf:"bytes,8,rep,name=MoveOrder" json:"MoveOrder,omitempty"`
+}
+
+func (m *GameFrame) Reset()                    { *m = GameFrame{} }
+func (m *GameFrame) String() string            { return proto.CompactTextString(m) }
+func (*GameFrame) ProtoMessage()               {}
+func (*GameFrame) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{27} }
+
+func (m *GameFrame) GetTurn() int32 {
 	if m != nil {
 		return m.Turn
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*ValidateSnakeRequest)(nil), "pb.ValidateSnakeRequest")
-	proto.RegisterType((*ValidateSnakeResponse)(nil), "pb.ValidateSnakeResponse")
-	proto.RegisterType((*SnakeResponseStatus)(nil), "pb.SnakeResponseStatus")
-	proto.RegisterType((*Score)(nil), "pb.Score")
-	proto.RegisterType((*PopRequest)(nil), "pb.PopRequest")
-	proto.RegisterType((*PopResponse)(nil), "pb.PopResponse")
-	proto.RegisterType((*StatusRequest)(nil), "pb.StatusRequest")
-	proto.RegisterType((*StatusResponse)(nil), "pb.StatusResponse")
-	proto.RegisterType((*StartRequest)(nil), "pb.StartRequest")
-	proto.RegisterType((*StartResponse)(nil), "pb.StartResponse")
-	proto.RegisterType((*CreateRequest)(nil), "pb.CreateRequest")
-	proto.RegisterType((*CreateResponse)(nil), "pb.CreateResponse")
-	proto.RegisterType((*AddGameFrameRequest)(nil), "pb.AddGameFrameRequest")
-	proto.RegisterType((*AddGameFrameResponse)(nil), "pb.AddGameFrameResponse")
-	proto.RegisterType((*ListGameFramesRequest)(nil), "pb.ListGameFramesRequest")
-	proto.RegisterType((*ListGameFramesResponse)(nil), "pb.ListGameFramesResponse")
-	proto.RegisterType((*EndGameRequest)(nil), "pb.EndGameRequest")
-	proto.RegisterType((*EndGameResponse)(nil), "pb.EndGameResponse")
-	proto.RegisterType((*PingRequest)(nil), "pb.PingRequest")
-	proto.RegisterType((*PingResponse)(nil), "pb.PingResponse")
-	proto.RegisterType((*SnakeOptions)(nil), "pb.SnakeOptions")
-	proto.RegisterType((*Game)(nil), "pb.Game")
-	proto.RegisterType((*GameFrame)(nil), "pb.GameFrame")
-	proto.RegisterType((*Point)(nil), "pb.Point")
-	proto.RegisterType((*Snake)(nil), "pb.Snake")
-	proto.RegisterType((*Death)(nil), "pb.Death")
+func (m *GameFrame) GetFood() []*Point {
+	if m != nil {
+		return m.Food
+	}
+	return nil
+}
+
+func (m *GameFrame) GetSnakes() []*Snake {
+	if m != nil {
+		return m.Snakes
+	}
+	return nil
+}
+
+func (m *GameFrame) GetTurnsSinceLastFoodSpawn() int32 {
+	if m != nil {
+		return m.TurnsSinceLastFoodSpawn
+	}
+	return 0
+}
+
+func (m *GameFrame) GetHazards() []*Point {
+	if m != nil {
+		return m.Hazards
+	}
+	return nil
+}
+
+func (m *GameFrame) GetWalls() []*Point {
+	if m != nil {
+		return m.Walls
+	}
+	return nil
+}
+
+func (m *GameFrame) GetFoodItems() []*FoodItem {
+	if m != nil {
+		return m.FoodItems
+	}
+	return nil
+}
+
+func (m *GameFrame) GetMoveOrder() []string {
+	if m != nil {
+		return m.MoveOrder
+	}
+	return nil
+}
+
+type FoodItem struct {
+	Point *Point `protobuf:"bytes,1,opt,name=Point" json:"Point,omitempty"`
+	Type  string `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+}
+
+func (m *FoodItem) Reset()                    { *m = FoodItem{} }
+func (m *FoodItem) String() string            { return proto.CompactTextString(m) }
+func (*FoodItem) ProtoMessage()               {}
+func (*FoodItem) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{28} }
+
+func (m *FoodItem) GetPoint() *Point {
+	if m != nil {
+		return m.Point
+	}
+	return nil
+}
+
+func (m *FoodItem) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+type Point struct {
+	X int32 `protobuf:"varint,1,opt,name=X,proto3" json:"X,omitempty"`
+	Y int32 `protobuf:"varint,2,opt,name=Y,proto3" json:"Y,omitempty"`
+}
+
+func (m *Point) Reset()                    { *m = Point{} }
+func (m *Point) String() string            { return proto.CompactTextString(m) }
+func (*Point) ProtoMessage()               {}
+func (*Point) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{29} }
+
+func (m *Point) GetX() int32 {
+	if m != nil {
+		return m.X
+	}
+	return 0
+}
+
+func (m *Point) GetY() int32 {
+	if m != nil {
+		return m.Y
+	}
+	return 0
+}
+
+type Snake struct {
+	ID                  string      `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                string      `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	URL                 string      `protobuf:"bytes,3,opt,name=URL,proto3" json:"URL,omitempty"`
+	Body                []*Point    `protobuf:"bytes,4,rep,name=Body" json:"Body,omitempty"`
+	Health              int32       `protobuf:"varint,5,opt,name=Health,proto3" json:"Health,omitempty"`
+	Death               *Death      `protobuf:"bytes,6,opt,name=Death" json:"Death,omitempty"`
+	Color               string      `protobuf:"bytes,7,opt,name=Color,proto3" json:"Color,omitempty"`
+	HeadType            string      `protobuf:"bytes,8,opt,name=HeadType,proto3" json:"HeadType,omitempty"`
+	TailType            string      `protobuf:"bytes,9,opt,name=TailType,proto3" json:"TailType,omitempty"`
+	Latency             string      `protobuf:"bytes,10,opt,name=Latency,proto3" json:"Latency,omitempty"`
+	Squad               string      `protobuf:"bytes,11,opt,name=Squad,proto3" json:"Squad,omitempty"`
+	TimeBank            int32       `protobuf:"varint,12,opt,name=TimeBank,proto3" json:"TimeBank,omitempty"`
+	ConsecutiveFailures int32       `protobuf:"varint,13,opt,name=ConsecutiveFailures,proto3" json:"ConsecutiveFailures,omitempty"`
+	TotalFailures       int32       `protobuf:"varint,14,opt,name=TotalFailures,proto3" json:"TotalFailures,omitempty"`
+	LastFailure         string      `protobuf:"bytes,15,opt,name=LastFailure,proto3" json:"LastFailure,omitempty"`
+	MoveResult          *MoveResult `protobuf:"bytes,16,opt,name=MoveResult" json:"MoveResult,omitempty"`
+	FoodEaten           int32       `protobuf:"varint,18,opt,name=FoodEaten,proto3" json:"FoodEaten,omitempty"`
+}
+
+func (m *Snake) Reset()                    { *m = Snake{} }
+func (m *Snake) String() string            { return proto.CompactTextString(m) }
+func (*Snake) ProtoMessage()               {}
+func (*Snake) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{30} }
+
+func (m *Snake) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Snake) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Snake) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *Snake) GetBody() []*Point {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+func (m *Snake) GetHealth() int32 {
+	if m != nil {
+		return m.Health
+	}
+	return 0
+}
+
+func (m *Snake) GetDeath() *Death {
+	if m != nil {
+		return m.Death
+	}
+	return nil
+}
+
+func (m *Snake) GetColor() string {
+	if m != nil {
+		return m.Color
+	}
+	return ""
+}
+
+func (m *Snake) GetHeadType() string {
+	if m != nil {
+		return m.HeadType
+	}
+	return ""
+}
+
+func (m *Snake) GetTailType() string {
+	if m != nil {
+		return m.TailType
+	}
+	return ""
+}
+
+func (m *Snake) GetLatency() string {
+	if m != nil {
+		return m.Latency
+	}
+	return ""
+}
+
+func (m *Snake) GetSquad() string {
+	if m != nil {
+		return m.Squad
+	}
+	return ""
+}
+
+func (m *Snake) GetTimeBank() int32 {
+	if m != nil {
+		return m.TimeBank
+	}
+	return 0
+}
+
+func (m *Snake) GetConsecutiveFailures() int32 {
+	if m != nil {
+		return m.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (m *Snake) GetTotalFailures() int32 {
+	if m != nil {
+		return m.TotalFailures
+	}
+	return 0
+}
+
+func (m *Snake) GetLastFailure() string {
+	if m != nil {
+		return m.LastFailure
+	}
+	return ""
+}
+
+func (m *Snake) GetMoveResult() *MoveResult {
+	if m != nil {
+		return m.MoveResult
+	}
+	return nil
+}
+
+func (m *Snake) GetFoodEaten() int32 {
+	if m != nil {
+		return m.FoodEaten
+	}
+	return 0
+}
+
+type Death struct {
+	Cause    string `protobuf:"bytes,1,opt,name=Cause,proto3" json:"Cause,omitempty"`
+	Turn     int32  `protobuf:"varint,2,opt,name=Turn,proto3" json:"Turn,omitempty"`
+	KilledBy string `protobuf:"bytes,3,opt,name=KilledBy,proto3" json:"KilledBy,omitempty"`
+}
+
+func (m *Death) Reset()                    { *m = Death{} }
+func (m *Death) String() string            { return proto.CompactTextString(m) }
+func (*Death) ProtoMessage()               {}
+func (*Death) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{31} }
+
+func (m *Death) GetCause() string {
+	if m != nil {
+		return m.Cause
+	}
+	return ""
+}
+
+func (m *Death) GetTurn() int32 {
+	if m != nil {
+		return m.Turn
+	}
+	return 0
+}
+
+func (m *Death) GetKilledBy() string {
+	if m != nil {
+		return m.KilledBy
+	}
+	return ""
+}
+
+type MoveResult struct {
+	Move       string `protobuf:"bytes,1,opt,name=Move,proto3" json:"Move,omitempty"`
+	Valid      bool   `protobuf:"varint,2,opt,name=Valid,proto3" json:"Valid,omitempty"`
+	Error      string `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+	StatusCode int32  `protobuf:"varint,4,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"`
+	Response   string `protobuf:"bytes,5,opt,name=Response,proto3" json:"Response,omitempty"`
+}
+
+func (m *MoveResult) Reset()                    { *m = MoveResult{} }
+func (m *MoveResult) String() string            { return proto.CompactTextString(m) }
+func (*MoveResult) ProtoMessage()               {}
+func (*MoveResult) Descriptor() ([]byte, []int) { return fileDescriptorController, []int{32} }
+
+func (m *MoveResult) GetMove() string {
+	if m != nil {
+		return m.Move
+	}
+	return ""
+}
+
+func (m *MoveResult) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *MoveResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *MoveResult) GetStatusCode() int32 {
+	if m != nil {
+		return m.StatusCode
+	}
+	return 0
+}
+
+func (m *MoveResult) GetResponse() string {
+	if m != nil {
+		return m.Response
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ValidateSnakeRequest)(nil), "pb.ValidateSnakeRequest")
+	proto.RegisterType((*ValidateSnakeResponse)(nil), "pb.ValidateSnakeResponse")
+	proto.RegisterType((*SnakeResponseStatus)(nil), "pb.SnakeResponseStatus")
+	proto.RegisterType((*Score)(nil), "pb.Score")
+	proto.RegisterType((*PopRequest)(nil), "pb.PopRequest")
+	proto.RegisterType((*PopResponse)(nil), "pb.PopResponse")
+	proto.RegisterType((*StatusRequest)(nil), "pb.StatusRequest")
+	proto.RegisterType((*StatusResponse)(nil), "pb.StatusResponse")
+	proto.RegisterType((*StartRequest)(nil), "pb.StartRequest")
+	proto.RegisterType((*StartResponse)(nil), "pb.StartResponse")
+	proto.RegisterType((*CreateRequest)(nil), "pb.CreateRequest")
+	proto.RegisterType((*CreateResponse)(nil), "pb.CreateResponse")
+	proto.RegisterType((*AddGameFrameRequest)(nil), "pb.AddGameFrameRequest")
+	proto.RegisterType((*AddGameFrameResponse)(nil), "pb.AddGameFrameResponse")
+	proto.RegisterType((*ListGameFramesRequest)(nil), "pb.ListGameFramesRequest")
+	proto.RegisterType((*ListGameFramesResponse)(nil), "pb.ListGameFramesResponse")
+	proto.RegisterType((*BestScoresRequest)(nil), "pb.BestScoresRequest")
+	proto.RegisterType((*BestScoresResponse)(nil), "pb.BestScoresResponse")
+	proto.RegisterType((*EndGameRequest)(nil), "pb.EndGameRequest")
+	proto.RegisterType((*EndGameResponse)(nil), "pb.EndGameResponse")
+	proto.RegisterType((*PingRequest)(nil), "pb.PingRequest")
+	proto.RegisterType((*PingResponse)(nil), "pb.PingResponse")
+	proto.RegisterType((*SnakeOptions)(nil), "pb.SnakeOptions")
+	proto.RegisterType((*Game)(nil), "pb.Game")
+	proto.RegisterType((*Script)(nil), "pb.Script")
+	proto.RegisterType((*GameResult)(nil), "pb.GameResult")
+	proto.RegisterType((*SoloScore)(nil), "pb.SoloScore")
+	proto.RegisterType((*GameFrame)(nil), "pb.GameFrame")
+	proto.RegisterType((*FoodItem)(nil), "pb.FoodItem")
+	proto.RegisterType((*Point)(nil), "pb.Point")
+	proto.RegisterType((*Snake)(nil), "pb.Snake")
+	proto.RegisterType((*Death)(nil), "pb.Death")
+	proto.RegisterType((*MoveResult)(nil), "pb.MoveResult")
+}
+func (this *ValidateSnakeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidateSnakeRequest)
+	if !ok {
+		that2, ok := that.(ValidateSnakeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.URL != that1.URL {
+		return false
+	}
+	return true
+}
+func (this *ValidateSnakeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidateSnakeResponse)
+	if !ok {
+		that2, ok := that.(ValidateSnakeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.StartStatus.Equal(that1.StartStatus) {
+		return false
+	}
+	if !this.MoveStatus.Equal(that1.MoveStatus) {
+		return false
+	}
+	if !this.EndStatus.Equal(that1.EndStatus) {
+		return false
+	}
+	if !this.PingStatus.Equal(that1.PingStatus) {
+		return false
+	}
+	return true
+}
+func (this *SnakeResponseStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SnakeResponseStatus)
+	if !ok {
+		that2, ok := that.(SnakeResponseStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Message != that1.Message {
+		return false
+	}
+	if len(this.Errors) != len(that1.Errors) {
+		return false
+	}
+	for i := range this.Errors {
+		if this.Errors[i] != that1.Errors[i] {
+			return false
+		}
+	}
+	if this.Time != that1.Time {
+		return false
+	}
+	if this.Raw != that1.Raw {
+		return false
+	}
+	if this.StatusCode != that1.StatusCode {
+		return false
+	}
+	if !this.Score.Equal(that1.Score) {
+		return false
+	}
+	return true
+}
+func (this *Score) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Score)
+	if !ok {
+		that2, ok := that.(Score)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ChecksPassed != that1.ChecksPassed {
+		return false
+	}
+	if this.ChecksFailed != that1.ChecksFailed {
+		return false
+	}
+	return true
+}
+func (this *PopRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PopRequest)
+	if !ok {
+		that2, ok := that.(PopRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *PopResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PopResponse)
+	if !ok {
+		that2, ok := that.(PopResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.Token != that1.Token {
+		return false
+	}
+	return true
+}
+func (this *StatusRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StatusRequest)
+	if !ok {
+		that2, ok := that.(StatusRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	return true
+}
+func (this *StatusResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StatusResponse)
+	if !ok {
+		that2, ok := that.(StatusResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Game.Equal(that1.Game) {
+		return false
+	}
+	if !this.LastFrame.Equal(that1.LastFrame) {
+		return false
+	}
+	return true
+}
+func (this *StartRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StartRequest)
+	if !ok {
+		that2, ok := that.(StartRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	return true
+}
+func (this *StartResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StartResponse)
+	if !ok {
+		that2, ok := that.(StartResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *CreateRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CreateRequest)
+	if !ok {
+		that2, ok := that.(CreateRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Width != that1.Width {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Food != that1.Food {
+		return false
+	}
+	if len(this.Snakes) != len(that1.Snakes) {
+		return false
+	}
+	for i := range this.Snakes {
+		if !this.Snakes[i].Equal(that1.Snakes[i]) {
+			return false
+		}
+	}
+	if this.MaxTurnsToNextFoodSpawn != that1.MaxTurnsToNextFoodSpawn {
+		return false
+	}
+	if this.SnakeTimeout != that1.SnakeTimeout {
+		return false
+	}
+	if this.Ruleset != that1.Ruleset {
+		return false
+	}
+	if this.Seed != that1.Seed {
+		return false
+	}
+	if this.Wrapped != that1.Wrapped {
+		return false
+	}
+	if this.HazardDamage != that1.HazardDamage {
+		return false
+	}
+	if this.ShrinkEveryNTurns != that1.ShrinkEveryNTurns {
+		return false
+	}
+	if this.AllowBodyCollisions != that1.AllowBodyCollisions {
+		return false
+	}
+	if this.SharedElimination != that1.SharedElimination {
+		return false
+	}
+	if this.SharedHealth != that1.SharedHealth {
+		return false
+	}
+	if this.SharedLength != that1.SharedLength {
+		return false
+	}
+	if this.StartingHealth != that1.StartingHealth {
+		return false
+	}
+	if this.FoodHealth != that1.FoodHealth {
+		return false
+	}
+	if this.HealthLossPerTurn != that1.HealthLossPerTurn {
+		return false
+	}
+	if this.StartingLength != that1.StartingLength {
+		return false
+	}
+	if this.MaxTurns != that1.MaxTurns {
+		return false
+	}
+	if this.Tiebreaker != that1.Tiebreaker {
+		return false
+	}
+	if this.Map != that1.Map {
+		return false
+	}
+	if this.MapGenerator != that1.MapGenerator {
+		return false
+	}
+	if this.FoodSpawner != that1.FoodSpawner {
+		return false
+	}
+	if this.MinimumFood != that1.MinimumFood {
+		return false
+	}
+	if this.FoodSpawnInterval != that1.FoodSpawnInterval {
+		return false
+	}
+	if this.FoodPlacement != that1.FoodPlacement {
+		return false
+	}
+	if this.FoodMinHeadDistance != that1.FoodMinHeadDistance {
+		return false
+	}
+	if len(this.FoodWeights) != len(that1.FoodWeights) {
+		return false
+	}
+	for i := range this.FoodWeights {
+		if this.FoodWeights[i] != that1.FoodWeights[i] {
+			return false
+		}
+	}
+	if this.GrowthPerFood != that1.GrowthPerFood {
+		return false
+	}
+	if this.HungryHealth != that1.HungryHealth {
+		return false
+	}
+	if this.MinimumLength != that1.MinimumLength {
+		return false
+	}
+	if this.MoveMode != that1.MoveMode {
+		return false
+	}
+	if this.VisionRadius != that1.VisionRadius {
+		return false
+	}
+	if this.VisionMetric != that1.VisionMetric {
+		return false
+	}
+	if this.TimeBank != that1.TimeBank {
+		return false
+	}
+	if this.TimeIncrement != that1.TimeIncrement {
+		return false
+	}
+	if this.FailurePolicy != that1.FailurePolicy {
+		return false
+	}
+	if this.MaxFailures != that1.MaxFailures {
+		return false
+	}
+	if this.Scenario != that1.Scenario {
+		return false
+	}
+	if this.Mode != that1.Mode {
+		return false
+	}
+	if this.EndCondition != that1.EndCondition {
+		return false
+	}
+	if this.SurvivorCount != that1.SurvivorCount {
+		return false
+	}
+	if this.TargetLength != that1.TargetLength {
+		return false
+	}
+	return true
 }
-func (this *ValidateSnakeRequest) Equal(that interface{}) bool {
+func (this *CreateResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ValidateSnakeRequest)
+	that1, ok := that.(*CreateResponse)
 	if !ok {
-		that2, ok := that.(ValidateSnakeRequest)
+		that2, ok := that.(CreateResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -841,19 +2290,19 @@ func (this *ValidateSnakeRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.URL != that1.URL {
+	if this.ID != that1.ID {
 		return false
 	}
 	return true
 }
-func (this *ValidateSnakeResponse) Equal(that interface{}) bool {
+func (this *AddGameFrameRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ValidateSnakeResponse)
+	that1, ok := that.(*AddGameFrameRequest)
 	if !ok {
-		that2, ok := that.(ValidateSnakeResponse)
+		that2, ok := that.(AddGameFrameRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -865,28 +2314,22 @@ func (this *ValidateSnakeResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.StartStatus.Equal(that1.StartStatus) {
-		return false
-	}
-	if !this.MoveStatus.Equal(that1.MoveStatus) {
-		return false
-	}
-	if !this.EndStatus.Equal(that1.EndStatus) {
+	if this.ID != that1.ID {
 		return false
 	}
-	if !this.PingStatus.Equal(that1.PingStatus) {
+	if !this.GameFrame.Equal(that1.GameFrame) {
 		return false
 	}
 	return true
 }
-func (this *SnakeResponseStatus) Equal(that interface{}) bool {
+func (this *AddGameFrameResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SnakeResponseStatus)
+	that1, ok := that.(*AddGameFrameResponse)
 	if !ok {
-		that2, ok := that.(SnakeResponseStatus)
+		that2, ok := that.(AddGameFrameResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -898,39 +2341,49 @@ func (this *SnakeResponseStatus) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Message != that1.Message {
+	if !this.Game.Equal(that1.Game) {
 		return false
 	}
-	if len(this.Errors) != len(that1.Errors) {
-		return false
+	return true
+}
+func (this *ListGameFramesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	for i := range this.Errors {
-		if this.Errors[i] != that1.Errors[i] {
+
+	that1, ok := that.(*ListGameFramesRequest)
+	if !ok {
+		that2, ok := that.(ListGameFramesRequest)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
-	if this.Time != that1.Time {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.Raw != that1.Raw {
+	if this.ID != that1.ID {
 		return false
 	}
-	if this.StatusCode != that1.StatusCode {
+	if this.Limit != that1.Limit {
 		return false
 	}
-	if !this.Score.Equal(that1.Score) {
+	if this.Offset != that1.Offset {
 		return false
 	}
 	return true
 }
-func (this *Score) Equal(that interface{}) bool {
+func (this *ListGameFramesResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Score)
+	that1, ok := that.(*ListGameFramesResponse)
 	if !ok {
-		that2, ok := that.(Score)
+		that2, ok := that.(ListGameFramesResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -942,22 +2395,27 @@ func (this *Score) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ChecksPassed != that1.ChecksPassed {
+	if len(this.Frames) != len(that1.Frames) {
 		return false
 	}
-	if this.ChecksFailed != that1.ChecksFailed {
+	for i := range this.Frames {
+		if !this.Frames[i].Equal(that1.Frames[i]) {
+			return false
+		}
+	}
+	if this.Count != that1.Count {
 		return false
 	}
 	return true
 }
-func (this *PopRequest) Equal(that interface{}) bool {
+func (this *BestScoresRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PopRequest)
+	that1, ok := that.(*BestScoresRequest)
 	if !ok {
-		that2, ok := that.(PopRequest)
+		that2, ok := that.(BestScoresRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -969,16 +2427,25 @@ func (this *PopRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.SnakeURL != that1.SnakeURL {
+		return false
+	}
+	if this.Width != that1.Width {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
 	return true
 }
-func (this *PopResponse) Equal(that interface{}) bool {
+func (this *BestScoresResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PopResponse)
+	that1, ok := that.(*BestScoresResponse)
 	if !ok {
-		that2, ok := that.(PopResponse)
+		that2, ok := that.(BestScoresResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -990,22 +2457,24 @@ func (this *PopResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ID != that1.ID {
+	if len(this.Scores) != len(that1.Scores) {
 		return false
 	}
-	if this.Token != that1.Token {
-		return false
+	for i := range this.Scores {
+		if !this.Scores[i].Equal(that1.Scores[i]) {
+			return false
+		}
 	}
 	return true
 }
-func (this *StatusRequest) Equal(that interface{}) bool {
+func (this *EndGameRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*StatusRequest)
+	that1, ok := that.(*EndGameRequest)
 	if !ok {
-		that2, ok := that.(StatusRequest)
+		that2, ok := that.(EndGameRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1020,16 +2489,19 @@ func (this *StatusRequest) Equal(that interface{}) bool {
 	if this.ID != that1.ID {
 		return false
 	}
+	if !this.Result.Equal(that1.Result) {
+		return false
+	}
 	return true
 }
-func (this *StatusResponse) Equal(that interface{}) bool {
+func (this *EndGameResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*StatusResponse)
+	that1, ok := that.(*EndGameResponse)
 	if !ok {
-		that2, ok := that.(StatusResponse)
+		that2, ok := that.(EndGameResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1041,22 +2513,16 @@ func (this *StatusResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Game.Equal(that1.Game) {
-		return false
-	}
-	if !this.LastFrame.Equal(that1.LastFrame) {
-		return false
-	}
 	return true
 }
-func (this *StartRequest) Equal(that interface{}) bool {
+func (this *PingRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*StartRequest)
+	that1, ok := that.(*PingRequest)
 	if !ok {
-		that2, ok := that.(StartRequest)
+		that2, ok := that.(PingRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1068,19 +2534,16 @@ func (this *StartRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ID != that1.ID {
-		return false
-	}
 	return true
 }
-func (this *StartResponse) Equal(that interface{}) bool {
+func (this *PingResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*StartResponse)
+	that1, ok := that.(*PingResponse)
 	if !ok {
-		that2, ok := that.(StartResponse)
+		that2, ok := that.(PingResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1092,16 +2555,19 @@ func (this *StartResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.Version != that1.Version {
+		return false
+	}
 	return true
 }
-func (this *CreateRequest) Equal(that interface{}) bool {
+func (this *SnakeOptions) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CreateRequest)
+	that1, ok := that.(*SnakeOptions)
 	if !ok {
-		that2, ok := that.(CreateRequest)
+		that2, ok := that.(SnakeOptions)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1113,176 +2579,217 @@ func (this *CreateRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Width != that1.Width {
+	if this.Name != that1.Name {
 		return false
 	}
-	if this.Height != that1.Height {
+	if this.URL != that1.URL {
 		return false
 	}
-	if this.Food != that1.Food {
+	if this.ID != that1.ID {
 		return false
 	}
-	if len(this.Snakes) != len(that1.Snakes) {
+	if this.HeadType != that1.HeadType {
 		return false
 	}
-	for i := range this.Snakes {
-		if !this.Snakes[i].Equal(that1.Snakes[i]) {
-			return false
-		}
-	}
-	if this.MaxTurnsToNextFoodSpawn != that1.MaxTurnsToNextFoodSpawn {
+	if this.TailType != that1.TailType {
 		return false
 	}
-	if this.SnakeTimeout != that1.SnakeTimeout {
+	if this.Squad != that1.Squad {
 		return false
 	}
 	return true
 }
-func (this *CreateResponse) Equal(that interface{}) bool {
+func (this *Game) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CreateResponse)
+	that1, ok := that.(*Game)
 	if !ok {
-		that2, ok := that.(CreateResponse)
+		that2, ok := that.(Game)
 		if ok {
 			that1 = &that2
 		} else {
 			return false
 		}
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.Width != that1.Width {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.SnakeTimeout != that1.SnakeTimeout {
+		return false
+	}
+	if this.Mode != that1.Mode {
+		return false
+	}
+	if this.MaxTurnsToNextFoodSpawn != that1.MaxTurnsToNextFoodSpawn {
+		return false
+	}
+	if this.TurnsSinceLastFoodSpawn != that1.TurnsSinceLastFoodSpawn {
+		return false
+	}
+	if this.Ruleset != that1.Ruleset {
+		return false
+	}
+	if this.Seed != that1.Seed {
+		return false
+	}
+	if this.Wrapped != that1.Wrapped {
+		return false
+	}
+	if this.HazardDamage != that1.HazardDamage {
+		return false
+	}
+	if this.ShrinkEveryNTurns != that1.ShrinkEveryNTurns {
+		return false
+	}
+	if this.AllowBodyCollisions != that1.AllowBodyCollisions {
+		return false
+	}
+	if this.SharedElimination != that1.SharedElimination {
+		return false
+	}
+	if this.SharedHealth != that1.SharedHealth {
+		return false
+	}
+	if this.SharedLength != that1.SharedLength {
+		return false
+	}
+	if this.StartingHealth != that1.StartingHealth {
+		return false
+	}
+	if this.FoodHealth != that1.FoodHealth {
+		return false
+	}
+	if this.HealthLossPerTurn != that1.HealthLossPerTurn {
+		return false
+	}
+	if this.StartingLength != that1.StartingLength {
+		return false
+	}
+	if this.MaxTurns != that1.MaxTurns {
+		return false
+	}
+	if this.Tiebreaker != that1.Tiebreaker {
+		return false
+	}
+	if !this.Result.Equal(that1.Result) {
+		return false
+	}
+	if this.Map != that1.Map {
+		return false
+	}
+	if len(this.FoodSpawns) != len(that1.FoodSpawns) {
+		return false
+	}
+	for i := range this.FoodSpawns {
+		if !this.FoodSpawns[i].Equal(that1.FoodSpawns[i]) {
+			return false
+		}
+	}
+	if this.FoodSpawner != that1.FoodSpawner {
+		return false
+	}
+	if this.MinimumFood != that1.MinimumFood {
+		return false
+	}
+	if this.FoodSpawnInterval != that1.FoodSpawnInterval {
+		return false
+	}
+	if this.FoodPlacement != that1.FoodPlacement {
 		return false
 	}
-	if this.ID != that1.ID {
+	if this.FoodMinHeadDistance != that1.FoodMinHeadDistance {
 		return false
 	}
-	return true
-}
-func (this *AddGameFrameRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if len(this.FoodWeights) != len(that1.FoodWeights) {
+		return false
 	}
-
-	that1, ok := that.(*AddGameFrameRequest)
-	if !ok {
-		that2, ok := that.(AddGameFrameRequest)
-		if ok {
-			that1 = &that2
-		} else {
+	for i := range this.FoodWeights {
+		if this.FoodWeights[i] != that1.FoodWeights[i] {
 			return false
 		}
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if this.GrowthPerFood != that1.GrowthPerFood {
 		return false
 	}
-	if this.ID != that1.ID {
+	if this.HungryHealth != that1.HungryHealth {
 		return false
 	}
-	if !this.GameFrame.Equal(that1.GameFrame) {
+	if this.MinimumLength != that1.MinimumLength {
 		return false
 	}
-	return true
-}
-func (this *AddGameFrameResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if this.MoveMode != that1.MoveMode {
+		return false
 	}
-
-	that1, ok := that.(*AddGameFrameResponse)
-	if !ok {
-		that2, ok := that.(AddGameFrameResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+	if this.VisionRadius != that1.VisionRadius {
+		return false
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if this.VisionMetric != that1.VisionMetric {
 		return false
 	}
-	if !this.Game.Equal(that1.Game) {
+	if this.TimeBank != that1.TimeBank {
 		return false
 	}
-	return true
-}
-func (this *ListGameFramesRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if this.TimeIncrement != that1.TimeIncrement {
+		return false
 	}
-
-	that1, ok := that.(*ListGameFramesRequest)
-	if !ok {
-		that2, ok := that.(ListGameFramesRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+	if this.FailurePolicy != that1.FailurePolicy {
+		return false
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if this.MaxFailures != that1.MaxFailures {
 		return false
 	}
-	if this.ID != that1.ID {
+	if this.Scenario != that1.Scenario {
 		return false
 	}
-	if this.Limit != that1.Limit {
+	if this.Goal != that1.Goal {
 		return false
 	}
-	if this.Offset != that1.Offset {
+	if this.GoalTurns != that1.GoalTurns {
 		return false
 	}
-	return true
-}
-func (this *ListGameFramesResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if this.EndCondition != that1.EndCondition {
+		return false
 	}
-
-	that1, ok := that.(*ListGameFramesResponse)
-	if !ok {
-		that2, ok := that.(ListGameFramesResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+	if this.SurvivorCount != that1.SurvivorCount {
+		return false
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if this.TargetLength != that1.TargetLength {
 		return false
 	}
-	if len(this.Frames) != len(that1.Frames) {
+	if len(this.Scripts) != len(that1.Scripts) {
 		return false
 	}
-	for i := range this.Frames {
-		if !this.Frames[i].Equal(that1.Frames[i]) {
+	for i := range this.Scripts {
+		if !this.Scripts[i].Equal(that1.Scripts[i]) {
 			return false
 		}
 	}
-	if this.Count != that1.Count {
-		return false
-	}
 	return true
 }
-func (this *EndGameRequest) Equal(that interface{}) bool {
+func (this *Script) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*EndGameRequest)
+	that1, ok := that.(*Script)
 	if !ok {
-		that2, ok := that.(EndGameRequest)
+		that2, ok := that.(Script)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1294,40 +2801,24 @@ func (this *EndGameRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ID != that1.ID {
+	if len(this.Moves) != len(that1.Moves) {
 		return false
 	}
-	return true
-}
-func (this *EndGameResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*EndGameResponse)
-	if !ok {
-		that2, ok := that.(EndGameResponse)
-		if ok {
-			that1 = &that2
-		} else {
+	for i := range this.Moves {
+		if this.Moves[i] != that1.Moves[i] {
 			return false
 		}
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
 	return true
 }
-func (this *PingRequest) Equal(that interface{}) bool {
+func (this *GameResult) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PingRequest)
+	that1, ok := that.(*GameResult)
 	if !ok {
-		that2, ok := that.(PingRequest)
+		that2, ok := that.(GameResult)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1339,40 +2830,41 @@ func (this *PingRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	return true
-}
-func (this *PingResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if len(this.Winners) != len(that1.Winners) {
+		return false
 	}
-
-	that1, ok := that.(*PingResponse)
-	if !ok {
-		that2, ok := that.(PingResponse)
-		if ok {
-			that1 = &that2
-		} else {
+	for i := range this.Winners {
+		if this.Winners[i] != that1.Winners[i] {
 			return false
 		}
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if this.Draw != that1.Draw {
 		return false
 	}
-	if this.Version != that1.Version {
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if !this.Score.Equal(that1.Score) {
+		return false
+	}
+	if len(this.Ranking) != len(that1.Ranking) {
 		return false
 	}
+	for i := range this.Ranking {
+		if this.Ranking[i] != that1.Ranking[i] {
+			return false
+		}
+	}
 	return true
 }
-func (this *SnakeOptions) Equal(that interface{}) bool {
+func (this *SoloScore) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SnakeOptions)
+	that1, ok := that.(*SoloScore)
 	if !ok {
-		that2, ok := that.(SnakeOptions)
+		that2, ok := that.(SoloScore)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1384,31 +2876,37 @@ func (this *SnakeOptions) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Name != that1.Name {
+	if this.GameID != that1.GameID {
 		return false
 	}
-	if this.URL != that1.URL {
+	if this.SnakeURL != that1.SnakeURL {
 		return false
 	}
-	if this.ID != that1.ID {
+	if this.Width != that1.Width {
 		return false
 	}
-	if this.HeadType != that1.HeadType {
+	if this.Height != that1.Height {
 		return false
 	}
-	if this.TailType != that1.TailType {
+	if this.Turns != that1.Turns {
+		return false
+	}
+	if this.FoodEaten != that1.FoodEaten {
+		return false
+	}
+	if this.Length != that1.Length {
 		return false
 	}
 	return true
 }
-func (this *Game) Equal(that interface{}) bool {
+func (this *GameFrame) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Game)
+	that1, ok := that.(*GameFrame)
 	if !ok {
-		that2, ok := that.(Game)
+		that2, ok := that.(GameFrame)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1420,40 +2918,70 @@ func (this *Game) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ID != that1.ID {
+	if this.Turn != that1.Turn {
 		return false
 	}
-	if this.Status != that1.Status {
+	if len(this.Food) != len(that1.Food) {
 		return false
 	}
-	if this.Width != that1.Width {
+	for i := range this.Food {
+		if !this.Food[i].Equal(that1.Food[i]) {
+			return false
+		}
+	}
+	if len(this.Snakes) != len(that1.Snakes) {
 		return false
 	}
-	if this.Height != that1.Height {
+	for i := range this.Snakes {
+		if !this.Snakes[i].Equal(that1.Snakes[i]) {
+			return false
+		}
+	}
+	if this.TurnsSinceLastFoodSpawn != that1.TurnsSinceLastFoodSpawn {
 		return false
 	}
-	if this.SnakeTimeout != that1.SnakeTimeout {
+	if len(this.Hazards) != len(that1.Hazards) {
 		return false
 	}
-	if this.Mode != that1.Mode {
+	for i := range this.Hazards {
+		if !this.Hazards[i].Equal(that1.Hazards[i]) {
+			return false
+		}
+	}
+	if len(this.Walls) != len(that1.Walls) {
 		return false
 	}
-	if this.MaxTurnsToNextFoodSpawn != that1.MaxTurnsToNextFoodSpawn {
+	for i := range this.Walls {
+		if !this.Walls[i].Equal(that1.Walls[i]) {
+			return false
+		}
+	}
+	if len(this.FoodItems) != len(that1.FoodItems) {
 		return false
 	}
-	if this.TurnsSinceLastFoodSpawn != that1.TurnsSinceLastFoodSpawn {
+	for i := range this.FoodItems {
+		if !this.FoodItems[i].Equal(that1.FoodItems[i]) {
+			return false
+		}
+	}
+	if len(this.MoveOrder) != len(that1.MoveOrder) {
 		return false
 	}
+	for i := range this.MoveOrder {
+		if this.MoveOrder[i] != that1.MoveOrder[i] {
+			return false
+		}
+	}
 	return true
 }
-func (this *GameFrame) Equal(that interface{}) bool {
+func (this *FoodItem) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GameFrame)
+	that1, ok := that.(*FoodItem)
 	if !ok {
-		that2, ok := that.(GameFrame)
+		that2, ok := that.(FoodItem)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1465,25 +2993,12 @@ func (this *GameFrame) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Turn != that1.Turn {
-		return false
-	}
-	if len(this.Food) != len(that1.Food) {
+	if !this.Point.Equal(that1.Point) {
 		return false
 	}
-	for i := range this.Food {
-		if !this.Food[i].Equal(that1.Food[i]) {
-			return false
-		}
-	}
-	if len(this.Snakes) != len(that1.Snakes) {
+	if this.Type != that1.Type {
 		return false
 	}
-	for i := range this.Snakes {
-		if !this.Snakes[i].Equal(that1.Snakes[i]) {
-			return false
-		}
-	}
 	return true
 }
 func (this *Point) Equal(that interface{}) bool {
@@ -1549,34 +3064,85 @@ func (this *Snake) Equal(that interface{}) bool {
 			return false
 		}
 	}
-	if this.Health != that1.Health {
-		return false
-	}
-	if !this.Death.Equal(that1.Death) {
-		return false
-	}
-	if this.Color != that1.Color {
+	if this.Health != that1.Health {
+		return false
+	}
+	if !this.Death.Equal(that1.Death) {
+		return false
+	}
+	if this.Color != that1.Color {
+		return false
+	}
+	if this.HeadType != that1.HeadType {
+		return false
+	}
+	if this.TailType != that1.TailType {
+		return false
+	}
+	if this.Latency != that1.Latency {
+		return false
+	}
+	if this.Squad != that1.Squad {
+		return false
+	}
+	if this.TimeBank != that1.TimeBank {
+		return false
+	}
+	if this.ConsecutiveFailures != that1.ConsecutiveFailures {
+		return false
+	}
+	if this.TotalFailures != that1.TotalFailures {
+		return false
+	}
+	if this.LastFailure != that1.LastFailure {
+		return false
+	}
+	if !this.MoveResult.Equal(that1.MoveResult) {
+		return false
+	}
+	if this.FoodEaten != that1.FoodEaten {
+		return false
+	}
+	return true
+}
+func (this *Death) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Death)
+	if !ok {
+		that2, ok := that.(Death)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.HeadType != that1.HeadType {
+	if this.Cause != that1.Cause {
 		return false
 	}
-	if this.TailType != that1.TailType {
+	if this.Turn != that1.Turn {
 		return false
 	}
-	if this.Latency != that1.Latency {
+	if this.KilledBy != that1.KilledBy {
 		return false
 	}
 	return true
 }
-func (this *Death) Equal(that interface{}) bool {
+func (this *MoveResult) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Death)
+	that1, ok := that.(*MoveResult)
 	if !ok {
-		that2, ok := that.(Death)
+		that2, ok := that.(MoveResult)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1588,10 +3154,19 @@ func (this *Death) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Cause != that1.Cause {
+	if this.Move != that1.Move {
 		return false
 	}
-	if this.Turn != that1.Turn {
+	if this.Valid != that1.Valid {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if this.StatusCode != that1.StatusCode {
+		return false
+	}
+	if this.Response != that1.Response {
 		return false
 	}
 	return true
@@ -1631,6 +3206,9 @@ type ControllerClient interface {
 	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
 	// ValidateSnake will call a snake URL and return stats about it's validity.
 	ValidateSnake(ctx context.Context, in *ValidateSnakeRequest, opts ...grpc.CallOption) (*ValidateSnakeResponse, error)
+	// BestScores returns a snake's best solo score on each board size it has
+	// played.
+	BestScores(ctx context.Context, in *BestScoresRequest, opts ...grpc.CallOption) (*BestScoresResponse, error)
 }
 
 type controllerClient struct {
@@ -1722,6 +3300,15 @@ func (c *controllerClient) ValidateSnake(ctx context.Context, in *ValidateSnakeR
 	return out, nil
 }
 
+func (c *controllerClient) BestScores(ctx context.Context, in *BestScoresRequest, opts ...grpc.CallOption) (*BestScoresResponse, error) {
+	out := new(BestScoresResponse)
+	err := grpc.Invoke(ctx, "/pb.Controller/BestScores", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Controller service
 
 type ControllerServer interface {
@@ -1748,6 +3335,9 @@ type ControllerServer interface {
 	Ping(context.Context, *PingRequest) (*PingResponse, error)
 	// ValidateSnake will call a snake URL and return stats about it's validity.
 	ValidateSnake(context.Context, *ValidateSnakeRequest) (*ValidateSnakeResponse, error)
+	// BestScores returns a snake's best solo score on each board size it has
+	// played.
+	BestScores(context.Context, *BestScoresRequest) (*BestScoresResponse, error)
 }
 
 func RegisterControllerServer(s *grpc.Server, srv ControllerServer) {
@@ -1916,6 +3506,24 @@ func _Controller_ValidateSnake_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Controller_BestScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BestScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).BestScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Controller/BestScores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).BestScores(ctx, req.(*BestScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Controller_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.Controller",
 	HandlerType: (*ControllerServer)(nil),
@@ -1956,6 +3564,10 @@ var _Controller_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ValidateSnake",
 			Handler:    _Controller_ValidateSnake_Handler,
 		},
+		{
+			MethodName: "BestScores",
+			Handler:    _Controller_BestScores_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller.proto",
@@ -2109,6 +3721,114 @@ func NewPopulatedCreateRequest(r randyController, easy bool) *CreateRequest {
 	if r.Intn(2) == 0 {
 		this.SnakeTimeout *= -1
 	}
+	this.Ruleset = string(randStringController(r))
+	this.Seed = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Seed *= -1
+	}
+	this.Wrapped = bool(bool(r.Intn(2) == 0))
+	this.HazardDamage = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.HazardDamage *= -1
+	}
+	this.ShrinkEveryNTurns = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.ShrinkEveryNTurns *= -1
+	}
+	this.AllowBodyCollisions = bool(bool(r.Intn(2) == 0))
+	this.SharedElimination = bool(bool(r.Intn(2) == 0))
+	this.SharedHealth = bool(bool(r.Intn(2) == 0))
+	this.SharedLength = bool(bool(r.Intn(2) == 0))
+	this.StartingHealth = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.StartingHealth *= -1
+	}
+	this.FoodHealth = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.FoodHealth *= -1
+	}
+	this.HealthLossPerTurn = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.HealthLossPerTurn *= -1
+	}
+	this.StartingLength = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.StartingLength *= -1
+	}
+	this.MaxTurns = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.MaxTurns *= -1
+	}
+	this.Tiebreaker = string(randStringController(r))
+	this.Map = string(randStringController(r))
+	this.MapGenerator = string(randStringController(r))
+	this.FoodSpawner = string(randStringController(r))
+	this.MinimumFood = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.MinimumFood *= -1
+	}
+	this.FoodSpawnInterval = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.FoodSpawnInterval *= -1
+	}
+	this.FoodPlacement = string(randStringController(r))
+	this.FoodMinHeadDistance = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.FoodMinHeadDistance *= -1
+	}
+	if r.Intn(10) != 0 {
+		v3 := r.Intn(10)
+		this.FoodWeights = make(map[string]int32)
+		for i := 0; i < v3; i++ {
+			v4 := randStringController(r)
+			this.FoodWeights[v4] = int32(r.Int31())
+			if r.Intn(2) == 0 {
+				this.FoodWeights[v4] *= -1
+			}
+		}
+	}
+	this.GrowthPerFood = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.GrowthPerFood *= -1
+	}
+	this.HungryHealth = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.HungryHealth *= -1
+	}
+	this.MinimumLength = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.MinimumLength *= -1
+	}
+	this.MoveMode = string(randStringController(r))
+	this.VisionRadius = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.VisionRadius *= -1
+	}
+	this.VisionMetric = string(randStringController(r))
+	this.TimeBank = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.TimeBank *= -1
+	}
+	this.TimeIncrement = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.TimeIncrement *= -1
+	}
+	this.FailurePolicy = string(randStringController(r))
+	this.MaxFailures = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.MaxFailures *= -1
+	}
+	this.Scenario = string(randStringController(r))
+	this.Mode = string(randStringController(r))
+	this.EndCondition = string(randStringController(r))
+	this.SurvivorCount = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.SurvivorCount *= -1
+	}
+	this.TargetLength = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.TargetLength *= -1
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2162,9 +3882,9 @@ func NewPopulatedListGameFramesRequest(r randyController, easy bool) *ListGameFr
 func NewPopulatedListGameFramesResponse(r randyController, easy bool) *ListGameFramesResponse {
 	this := &ListGameFramesResponse{}
 	if r.Intn(10) != 0 {
-		v3 := r.Intn(5)
-		this.Frames = make([]*GameFrame, v3)
-		for i := 0; i < v3; i++ {
+		v5 := r.Intn(5)
+		this.Frames = make([]*GameFrame, v5)
+		for i := 0; i < v5; i++ {
 			this.Frames[i] = NewPopulatedGameFrame(r, easy)
 		}
 	}
@@ -2177,9 +3897,42 @@ func NewPopulatedListGameFramesResponse(r randyController, easy bool) *ListGameF
 	return this
 }
 
+func NewPopulatedBestScoresRequest(r randyController, easy bool) *BestScoresRequest {
+	this := &BestScoresRequest{}
+	this.SnakeURL = string(randStringController(r))
+	this.Width = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Width *= -1
+	}
+	this.Height = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Height *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedBestScoresResponse(r randyController, easy bool) *BestScoresResponse {
+	this := &BestScoresResponse{}
+	if r.Intn(10) != 0 {
+		v6 := r.Intn(5)
+		this.Scores = make([]*SoloScore, v6)
+		for i := 0; i < v6; i++ {
+			this.Scores[i] = NewPopulatedSoloScore(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedEndGameRequest(r randyController, easy bool) *EndGameRequest {
 	this := &EndGameRequest{}
 	this.ID = string(randStringController(r))
+	if r.Intn(10) != 0 {
+		this.Result = NewPopulatedGameResult(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2214,6 +3967,7 @@ func NewPopulatedSnakeOptions(r randyController, easy bool) *SnakeOptions {
 	this.ID = string(randStringController(r))
 	this.HeadType = string(randStringController(r))
 	this.TailType = string(randStringController(r))
+	this.Squad = string(randStringController(r))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2244,6 +3998,197 @@ func NewPopulatedGame(r randyController, easy bool) *Game {
 	if r.Intn(2) == 0 {
 		this.TurnsSinceLastFoodSpawn *= -1
 	}
+	this.Ruleset = string(randStringController(r))
+	this.Seed = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Seed *= -1
+	}
+	this.Wrapped = bool(bool(r.Intn(2) == 0))
+	this.HazardDamage = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.HazardDamage *= -1
+	}
+	this.ShrinkEveryNTurns = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.ShrinkEveryNTurns *= -1
+	}
+	this.AllowBodyCollisions = bool(bool(r.Intn(2) == 0))
+	this.SharedElimination = bool(bool(r.Intn(2) == 0))
+	this.SharedHealth = bool(bool(r.Intn(2) == 0))
+	this.SharedLength = bool(bool(r.Intn(2) == 0))
+	this.StartingHealth = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.StartingHealth *= -1
+	}
+	this.FoodHealth = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.FoodHealth *= -1
+	}
+	this.HealthLossPerTurn = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.HealthLossPerTurn *= -1
+	}
+	this.StartingLength = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.StartingLength *= -1
+	}
+	this.MaxTurns = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.MaxTurns *= -1
+	}
+	this.Tiebreaker = string(randStringController(r))
+	if r.Intn(10) != 0 {
+		this.Result = NewPopulatedGameResult(r, easy)
+	}
+	this.Map = string(randStringController(r))
+	if r.Intn(10) != 0 {
+		v7 := r.Intn(5)
+		this.FoodSpawns = make([]*Point, v7)
+		for i := 0; i < v7; i++ {
+			this.FoodSpawns[i] = NewPopulatedPoint(r, easy)
+		}
+	}
+	this.FoodSpawner = string(randStringController(r))
+	this.MinimumFood = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.MinimumFood *= -1
+	}
+	this.FoodSpawnInterval = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.FoodSpawnInterval *= -1
+	}
+	this.FoodPlacement = string(randStringController(r))
+	this.FoodMinHeadDistance = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.FoodMinHeadDistance *= -1
+	}
+	if r.Intn(10) != 0 {
+		v8 := r.Intn(10)
+		this.FoodWeights = make(map[string]int32)
+		for i := 0; i < v8; i++ {
+			v9 := randStringController(r)
+			this.FoodWeights[v9] = int32(r.Int31())
+			if r.Intn(2) == 0 {
+				this.FoodWeights[v9] *= -1
+			}
+		}
+	}
+	this.GrowthPerFood = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.GrowthPerFood *= -1
+	}
+	this.HungryHealth = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.HungryHealth *= -1
+	}
+	this.MinimumLength = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.MinimumLength *= -1
+	}
+	this.MoveMode = string(randStringController(r))
+	this.VisionRadius = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.VisionRadius *= -1
+	}
+	this.VisionMetric = string(randStringController(r))
+	this.TimeBank = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.TimeBank *= -1
+	}
+	this.TimeIncrement = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.TimeIncrement *= -1
+	}
+	this.FailurePolicy = string(randStringController(r))
+	this.MaxFailures = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.MaxFailures *= -1
+	}
+	this.Scenario = string(randStringController(r))
+	this.Goal = string(randStringController(r))
+	this.GoalTurns = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.GoalTurns *= -1
+	}
+	this.EndCondition = string(randStringController(r))
+	this.SurvivorCount = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.SurvivorCount *= -1
+	}
+	this.TargetLength = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.TargetLength *= -1
+	}
+	if r.Intn(10) != 0 {
+		v10 := r.Intn(10)
+		this.Scripts = make(map[string]*Script)
+		for i := 0; i < v10; i++ {
+			this.Scripts[randStringController(r)] = NewPopulatedScript(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedScript(r randyController, easy bool) *Script {
+	this := &Script{}
+	v11 := r.Intn(10)
+	this.Moves = make([]string, v11)
+	for i := 0; i < v11; i++ {
+		this.Moves[i] = string(randStringController(r))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGameResult(r randyController, easy bool) *GameResult {
+	this := &GameResult{}
+	v12 := r.Intn(10)
+	this.Winners = make([]string, v12)
+	for i := 0; i < v12; i++ {
+		this.Winners[i] = string(randStringController(r))
+	}
+	this.Draw = bool(bool(r.Intn(2) == 0))
+	this.Reason = string(randStringController(r))
+	if r.Intn(10) != 0 {
+		this.Score = NewPopulatedSoloScore(r, easy)
+	}
+	v13 := r.Intn(10)
+	this.Ranking = make([]string, v13)
+	for i := 0; i < v13; i++ {
+		this.Ranking[i] = string(randStringController(r))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSoloScore(r randyController, easy bool) *SoloScore {
+	this := &SoloScore{}
+	this.GameID = string(randStringController(r))
+	this.SnakeURL = string(randStringController(r))
+	this.Width = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Width *= -1
+	}
+	this.Height = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Height *= -1
+	}
+	this.Turns = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Turns *= -1
+	}
+	this.FoodEaten = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.FoodEaten *= -1
+	}
+	this.Length = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Length *= -1
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2256,19 +4201,60 @@ func NewPopulatedGameFrame(r randyController, easy bool) *GameFrame {
 		this.Turn *= -1
 	}
 	if r.Intn(10) != 0 {
-		v4 := r.Intn(5)
-		this.Food = make([]*Point, v4)
-		for i := 0; i < v4; i++ {
+		v14 := r.Intn(5)
+		this.Food = make([]*Point, v14)
+		for i := 0; i < v14; i++ {
 			this.Food[i] = NewPopulatedPoint(r, easy)
 		}
 	}
 	if r.Intn(10) != 0 {
-		v5 := r.Intn(5)
-		this.Snakes = make([]*Snake, v5)
-		for i := 0; i < v5; i++ {
+		v15 := r.Intn(5)
+		this.Snakes = make([]*Snake, v15)
+		for i := 0; i < v15; i++ {
 			this.Snakes[i] = NewPopulatedSnake(r, easy)
 		}
 	}
+	this.TurnsSinceLastFoodSpawn = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.TurnsSinceLastFoodSpawn *= -1
+	}
+	if r.Intn(10) != 0 {
+		v16 := r.Intn(5)
+		this.Hazards = make([]*Point, v16)
+		for i := 0; i < v16; i++ {
+			this.Hazards[i] = NewPopulatedPoint(r, easy)
+		}
+	}
+	if r.Intn(10) != 0 {
+		v17 := r.Intn(5)
+		this.Walls = make([]*Point, v17)
+		for i := 0; i < v17; i++ {
+			this.Walls[i] = NewPopulatedPoint(r, easy)
+		}
+	}
+	if r.Intn(10) != 0 {
+		v18 := r.Intn(5)
+		this.FoodItems = make([]*FoodItem, v18)
+		for i := 0; i < v18; i++ {
+			this.FoodItems[i] = NewPopulatedFoodItem(r, easy)
+		}
+	}
+	v19 := r.Intn(10)
+	this.MoveOrder = make([]string, v19)
+	for i := 0; i < v19; i++ {
+		this.MoveOrder[i] = string(randStringController(r))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedFoodItem(r randyController, easy bool) *FoodItem {
+	this := &FoodItem{}
+	if r.Intn(10) != 0 {
+		this.Point = NewPopulatedPoint(r, easy)
+	}
+	this.Type = string(randStringController(r))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2295,9 +4281,9 @@ func NewPopulatedSnake(r randyController, easy bool) *Snake {
 	this.Name = string(randStringController(r))
 	this.URL = string(randStringController(r))
 	if r.Intn(10) != 0 {
-		v6 := r.Intn(5)
-		this.Body = make([]*Point, v6)
-		for i := 0; i < v6; i++ {
+		v20 := r.Intn(5)
+		this.Body = make([]*Point, v20)
+		for i := 0; i < v20; i++ {
 			this.Body[i] = NewPopulatedPoint(r, easy)
 		}
 	}
@@ -2312,6 +4298,27 @@ func NewPopulatedSnake(r randyController, easy bool) *Snake {
 	this.HeadType = string(randStringController(r))
 	this.TailType = string(randStringController(r))
 	this.Latency = string(randStringController(r))
+	this.Squad = string(randStringController(r))
+	this.TimeBank = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.TimeBank *= -1
+	}
+	this.ConsecutiveFailures = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.ConsecutiveFailures *= -1
+	}
+	this.TotalFailures = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.TotalFailures *= -1
+	}
+	this.LastFailure = string(randStringController(r))
+	if r.Intn(10) != 0 {
+		this.MoveResult = NewPopulatedMoveResult(r, easy)
+	}
+	this.FoodEaten = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.FoodEaten *= -1
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2324,6 +4331,22 @@ func NewPopulatedDeath(r randyController, easy bool) *Death {
 	if r.Intn(2) == 0 {
 		this.Turn *= -1
 	}
+	this.KilledBy = string(randStringController(r))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedMoveResult(r randyController, easy bool) *MoveResult {
+	this := &MoveResult{}
+	this.Move = string(randStringController(r))
+	this.Valid = bool(bool(r.Intn(2) == 0))
+	this.Error = string(randStringController(r))
+	this.StatusCode = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.StatusCode *= -1
+	}
+	this.Response = string(randStringController(r))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2348,9 +4371,9 @@ func randUTF8RuneController(r randyController) rune {
 	return rune(ru + 61)
 }
 func randStringController(r randyController) string {
-	v7 := r.Intn(100)
-	tmps := make([]rune, v7)
-	for i := 0; i < v7; i++ {
+	v21 := r.Intn(100)
+	tmps := make([]rune, v21)
+	for i := 0; i < v21; i++ {
 		tmps[i] = randUTF8RuneController(r)
 	}
 	return string(tmps)
@@ -2372,11 +4395,11 @@ func randFieldController(dAtA []byte, r randyController, fieldNumber int, wire i
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateController(dAtA, uint64(key))
-		v8 := r.Int63()
+		v22 := r.Int63()
 		if r.Intn(2) == 0 {
-			v8 *= -1
+			v22 *= -1
 		}
-		dAtA = encodeVarintPopulateController(dAtA, uint64(v8))
+		dAtA = encodeVarintPopulateController(dAtA, uint64(v22))
 	case 1:
 		dAtA = encodeVarintPopulateController(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -2405,75 +4428,155 @@ func encodeVarintPopulateController(dAtA []byte, v uint64) []byte {
 func init() { proto.RegisterFile("controller.proto", fileDescriptorController) }
 
 var fileDescriptorController = []byte{
-	// 1116 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x8c, 0x56, 0xdd, 0x6e, 0x1b, 0xc5,
-	0x17, 0xd7, 0x7a, 0xe3, 0x24, 0x3e, 0x71, 0x1c, 0x67, 0x92, 0xb6, 0xee, 0xea, 0xff, 0x4f, 0xcb,
-	0x20, 0x50, 0x10, 0x90, 0x88, 0x14, 0x04, 0x5c, 0xb6, 0x49, 0x93, 0x22, 0x39, 0x4d, 0xb4, 0x71,
-	0x4b, 0x0b, 0x57, 0x6b, 0xef, 0xc4, 0x5e, 0xc5, 0xd9, 0x31, 0xbb, 0x6b, 0xda, 0x48, 0x3c, 0x00,
-	0x8f, 0xc2, 0x15, 0xd7, 0xbc, 0x0a, 0x97, 0xf0, 0x0e, 0x48, 0x48, 0x08, 0x89, 0x99, 0x33, 0x67,
-	0xbf, 0xe2, 0xb5, 0xe1, 0xc2, 0xd6, 0x9c, 0xcf, 0x99, 0x39, 0xe7, 0x77, 0x7e, 0xb3, 0xd0, 0x1e,
-	0xc8, 0x30, 0x89, 0xe4, 0x78, 0x2c, 0xa2, 0xbd, 0x49, 0x24, 0x13, 0xc9, 0x6a, 0x93, 0xbe, 0xf3,
-	0xf1, 0x30, 0x48, 0x46, 0xd3, 0xfe, 0xde, 0x40, 0x5e, 0xef, 0x0f, 0xe5, 0x50, 0xee, 0xa3, 0xa9,
-	0x3f, 0xbd, 0x44, 0x09, 0x05, 0x5c, 0x99, 0x10, 0xbe, 0x0b, 0xdb, 0x2f, 0xbd, 0x71, 0xe0, 0x7b,
-	0x89, 0xb8, 0x08, 0xbd, 0x2b, 0xe1, 0x8a, 0xef, 0xa6, 0x22, 0x4e, 0x58, 0x1b, 0xec, 0x17, 0x6e,
-	0xb7, 0x63, 0x3d, 0xb4, 0x76, 0x1b, 0xae, 0x5e, 0xf2, 0xbf, 0x2c, 0xb8, 0x73, 0xcb, 0x35, 0x9e,
-	0xc8, 0x30, 0x16, 0xec, 0x4b, 0x58, 0xbb, 0x48, 0xbc, 0x28, 0x51, 0x7f, 0xc9, 0x34, 0xc6, 0x98,
-	0xb5, 0x83, 0x7b, 0x7b, 0x93, 0xfe, 0x5e, 0xc9, 0xcf, 0x98, 0xdd, 0xa2, 0x2f, 0xfb, 0x1c, 0xe0,
-	0x54, 0x7e, 0x4f, 0xa6, 0x4e, 0x6d, 0x71, 0x64, 0xc1, 0x95, 0x7d, 0x06, 0x8d, 0xa7, 0xa1, 0x4f,
-	0x71, 0xf6, 0xe2, 0xb8, 0xdc, 0x53, 0xef, 0x77, 0x1e, 0x84, 0x43, 0x8a, 0x5b, 0xfa, 0x97, 0xfd,
-	0x72, 0x57, 0xfe, 0xb3, 0x05, 0x5b, 0x15, 0x3e, 0xac, 0x03, 0x2b, 0xa7, 0x22, 0x8e, 0xbd, 0xa1,
-	0xa0, 0x5a, 0xa5, 0x22, 0xbb, 0x0b, 0xcb, 0x4f, 0xa3, 0x48, 0x46, 0xfa, 0x5a, 0xb6, 0x32, 0x90,
-	0xc4, 0x18, 0x2c, 0x25, 0xc1, 0xb5, 0xc0, 0x43, 0xd7, 0x5d, 0x5c, 0xeb, 0x6a, 0x47, 0xde, 0x1b,
-	0x3c, 0x8f, 0xaa, 0xb6, 0x5a, 0xb2, 0x1d, 0x80, 0x18, 0x77, 0x38, 0x94, 0xbe, 0xe8, 0xd4, 0xd1,
-	0xb7, 0xa0, 0x61, 0x0f, 0xa0, 0x1e, 0x0f, 0x64, 0x24, 0x3a, 0xcb, 0x78, 0x87, 0x06, 0xde, 0x41,
-	0x2b, 0x5c, 0xa3, 0xe7, 0x67, 0x50, 0x47, 0x99, 0x71, 0x68, 0x0e, 0x46, 0x62, 0x70, 0x15, 0x9f,
-	0x7b, 0x71, 0x2c, 0x7c, 0x3c, 0x66, 0xdd, 0x2d, 0xe9, 0x72, 0x9f, 0x63, 0x2f, 0x18, 0x2b, 0x9f,
-	0x5a, 0xd1, 0xc7, 0xe8, 0x78, 0x53, 0x95, 0x4e, 0x4e, 0x08, 0x1f, 0xfc, 0x11, 0xac, 0xa1, 0x44,
-	0x10, 0x68, 0x41, 0xed, 0xab, 0x23, 0xaa, 0x80, 0x5a, 0xb1, 0x6d, 0xa8, 0xf7, 0xe4, 0x95, 0x08,
-	0x31, 0x53, 0xc3, 0x35, 0x02, 0x7f, 0x00, 0xeb, 0x54, 0x5a, 0x42, 0xd9, 0xad, 0x30, 0xfe, 0x2d,
-	0xb4, 0x52, 0x07, 0x4a, 0xfc, 0x3f, 0x58, 0x3a, 0xf1, 0xae, 0x05, 0x81, 0x6a, 0x55, 0x5f, 0x53,
-	0xcb, 0x2e, 0x6a, 0xd9, 0x87, 0xd0, 0xe8, 0x7a, 0x71, 0x72, 0x1c, 0x69, 0x17, 0x83, 0x9e, 0xf5,
-	0xd4, 0x05, 0x95, 0x6e, 0x6e, 0xe7, 0x3b, 0xd0, 0x44, 0xe8, 0xcd, 0xdb, 0x7c, 0x03, 0x4f, 0xa7,
-	0xed, 0x66, 0x6f, 0xfe, 0xab, 0x05, 0xeb, 0x87, 0x91, 0x50, 0x78, 0x4f, 0x43, 0xd4, 0xb5, 0xbe,
-	0x0e, 0xfc, 0x64, 0x44, 0x45, 0x34, 0x82, 0xee, 0xf4, 0x33, 0x11, 0x0c, 0x47, 0x09, 0xd5, 0x8d,
-	0x24, 0xdd, 0xe9, 0x63, 0x29, 0xfd, 0xb4, 0xd3, 0x7a, 0xcd, 0x76, 0x61, 0x19, 0x61, 0xa4, 0xc1,
-	0x67, 0xab, 0xe3, 0xb6, 0x33, 0xf0, 0x9d, 0x4d, 0x92, 0x40, 0x6d, 0xeb, 0x92, 0x9d, 0x7d, 0x01,
-	0xf7, 0x4e, 0xbd, 0xb7, 0xbd, 0x69, 0x14, 0xc6, 0x3d, 0xf9, 0x5c, 0xbc, 0x4d, 0x74, 0xfc, 0xc5,
-	0xc4, 0x7b, 0x13, 0x12, 0x1c, 0xe6, 0x99, 0x75, 0x37, 0x31, 0x47, 0x4f, 0x41, 0x4b, 0x4e, 0x13,
-	0x84, 0x88, 0xea, 0x66, 0x51, 0xc7, 0x1f, 0x42, 0x2b, 0xbd, 0x5a, 0x75, 0x0b, 0xb9, 0x0b, 0x5b,
-	0x8f, 0x7d, 0x3f, 0xaf, 0x64, 0x75, 0xd5, 0x74, 0x0b, 0x32, 0x9f, 0x39, 0x2d, 0xc8, 0x96, 0xfc,
-	0x53, 0xd8, 0x2e, 0xe7, 0xcc, 0xbb, 0x3c, 0xac, 0xec, 0xb2, 0xd6, 0xf2, 0x17, 0x70, 0xa7, 0x1b,
-	0xc4, 0x49, 0x16, 0x36, 0x0f, 0x3e, 0xba, 0x3d, 0xdd, 0xe0, 0x3a, 0x48, 0xfb, 0x60, 0x04, 0xdd,
-	0x9e, 0xb3, 0xcb, 0xcb, 0x58, 0x24, 0xd4, 0x08, 0x92, 0x54, 0xda, 0xbb, 0xb7, 0xd3, 0xd2, 0x71,
-	0xde, 0x83, 0x65, 0xa3, 0x51, 0xb9, 0xed, 0xd9, 0x0b, 0x91, 0x51, 0x6f, 0x77, 0x28, 0xa7, 0x61,
-	0xb6, 0x1d, 0x0a, 0xba, 0xb2, 0x8a, 0x6f, 0x4e, 0xe6, 0x97, 0x8c, 0x6f, 0xc2, 0x46, 0xe6, 0x41,
-	0x50, 0x5b, 0x57, 0xe3, 0xa4, 0xc8, 0x26, 0x9d, 0xae, 0x5d, 0x68, 0x1a, 0x91, 0x0e, 0xa4, 0x58,
-	0xe6, 0xa5, 0x88, 0x62, 0x85, 0x8f, 0x94, 0x65, 0x48, 0xe4, 0x3f, 0x50, 0xaf, 0x09, 0x3d, 0x1a,
-	0x73, 0xcf, 0xd3, 0x4a, 0x36, 0x5c, 0x5c, 0xa7, 0x5c, 0x5e, 0xcb, 0xb8, 0x9c, 0x4e, 0x64, 0x67,
-	0x85, 0x73, 0x60, 0xf5, 0x99, 0xf0, 0xfc, 0xde, 0xcd, 0x44, 0x10, 0x09, 0x65, 0xb2, 0xb6, 0xf5,
-	0x14, 0x03, 0xa0, 0xad, 0x6e, 0x6c, 0xa9, 0xcc, 0x7f, 0xac, 0x99, 0xf1, 0x9c, 0xe9, 0x84, 0xaa,
-	0x79, 0x81, 0xd3, 0x15, 0xf9, 0x11, 0x5d, 0x66, 0x03, 0x64, 0x57, 0x0f, 0xd0, 0x52, 0x69, 0x80,
-	0xfe, 0x03, 0x90, 0xf5, 0x85, 0x4f, 0x35, 0x45, 0xae, 0x9a, 0x0b, 0xeb, 0xf5, 0xa2, 0xd1, 0x69,
-	0x2c, 0x1e, 0x1d, 0x15, 0x89, 0xfa, 0x8b, 0x20, 0x1c, 0x08, 0xa4, 0x8e, 0x2c, 0x12, 0x4c, 0xe4,
-	0x1c, 0x33, 0xf7, 0x0a, 0x73, 0xa0, 0x0f, 0xa5, 0xfd, 0x88, 0x26, 0x70, 0xcd, 0xfe, 0x4f, 0x6c,
-	0x50, 0x43, 0x48, 0x21, 0x61, 0x9f, 0xcb, 0x20, 0x4c, 0x88, 0x18, 0xde, 0xc9, 0x88, 0xc1, 0xce,
-	0x1d, 0xcc, 0x8b, 0x43, 0x06, 0xfe, 0x2e, 0xd4, 0x31, 0x82, 0x35, 0xc1, 0x7a, 0x45, 0xb9, 0xad,
-	0x57, 0x5a, 0x7a, 0x4d, 0x10, 0xb4, 0x5e, 0xf3, 0xbf, 0x2d, 0x45, 0xfc, 0xda, 0x7f, 0xa6, 0x27,
-	0x29, 0x34, 0x6a, 0xb3, 0xd0, 0xb0, 0x73, 0x68, 0xa8, 0x63, 0x3e, 0x91, 0xfe, 0x0d, 0xd1, 0x53,
-	0xf1, 0x98, 0x5a, 0x6d, 0x5a, 0xe5, 0x8d, 0x55, 0x07, 0xeb, 0x69, 0xab, 0xb4, 0xa4, 0xdf, 0xa3,
-	0x23, 0x45, 0x27, 0xa3, 0xe2, 0x7b, 0x84, 0x0a, 0xd7, 0xe8, 0xcd, 0xb0, 0x8c, 0x65, 0xd4, 0x59,
-	0x31, 0x2f, 0x02, 0x0a, 0x25, 0xe0, 0xad, 0x2e, 0x00, 0x5e, 0xa3, 0x0c, 0x3c, 0x3d, 0x10, 0x5d,
-	0x45, 0x5e, 0xe1, 0xe0, 0x06, 0xfb, 0xa2, 0x06, 0x82, 0x44, 0xfe, 0x09, 0x14, 0x36, 0xf4, 0xa6,
-	0x71, 0x3a, 0x0a, 0x46, 0xc8, 0x3a, 0x53, 0xcb, 0x3b, 0x73, 0xf0, 0x87, 0x0d, 0x70, 0x98, 0x7d,
-	0x4b, 0xb1, 0xf7, 0xc1, 0x56, 0x4f, 0x1b, 0x6b, 0x99, 0xab, 0xa7, 0x2f, 0x9e, 0xb3, 0x91, 0xc9,
-	0x34, 0x94, 0xfb, 0x29, 0xc6, 0xd9, 0x26, 0xf6, 0xaa, 0xf8, 0xb2, 0x39, 0xac, 0xa8, 0xa2, 0x80,
-	0x8f, 0x54, 0x67, 0xf4, 0x03, 0xc3, 0xda, 0x64, 0xcc, 0xde, 0x22, 0x67, 0xb3, 0xa0, 0xc9, 0xd3,
-	0x1b, 0x86, 0x36, 0xe9, 0x4b, 0x0f, 0x91, 0x49, 0x7f, 0x8b, 0xc0, 0x1f, 0x43, 0xb3, 0x48, 0xae,
-	0x0c, 0xbf, 0x6b, 0x2a, 0x28, 0xdc, 0xe9, 0xcc, 0x1a, 0x28, 0xc5, 0x09, 0xb4, 0xca, 0x94, 0xc8,
-	0xee, 0x6b, 0xdf, 0x4a, 0xf6, 0x75, 0x9c, 0x2a, 0x13, 0x25, 0x3a, 0x80, 0x15, 0xa2, 0x38, 0x86,
-	0x47, 0x2d, 0x33, 0xa2, 0xb3, 0x55, 0xd2, 0x51, 0xcc, 0x07, 0xb0, 0xa4, 0x49, 0x8f, 0x99, 0x42,
-	0xe7, 0x6c, 0xe8, 0xb4, 0x73, 0x05, 0xb9, 0x1e, 0xc1, 0x7a, 0xe9, 0x53, 0x94, 0xe1, 0x95, 0xaa,
-	0x3e, 0x64, 0x9d, 0xfb, 0x15, 0x16, 0x93, 0xe5, 0x49, 0xfb, 0xcf, 0xdf, 0x76, 0xac, 0x9f, 0x7e,
-	0xdf, 0xb1, 0x7e, 0x51, 0xbf, 0x6f, 0xd4, 0xc7, 0x73, 0x7f, 0x19, 0x3f, 0x8a, 0x1f, 0xfd, 0x13,
-	0x00, 0x00, 0xff, 0xff, 0x2c, 0x98, 0x31, 0x7c, 0x5b, 0x0b, 0x00, 0x00,
+	// 2397 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x59, 0xdd, 0x6e, 0x1b, 0xbb,
+	0xf1, 0x87, 0x24, 0xcb, 0x96, 0xc6, 0xb2, 0x22, 0xd3, 0x8e, 0xc3, 0xe8, 0x24, 0x8e, 0xb3, 0xf9,
+	0x38, 0xce, 0xc7, 0x71, 0xf2, 0x77, 0xfe, 0x45, 0xd3, 0x06, 0x68, 0x91, 0xd8, 0x4e, 0xe2, 0xd6,
+	0x4a, 0x8c, 0x95, 0x93, 0x9c, 0xd3, 0xa2, 0x17, 0xb4, 0xc4, 0xc8, 0x0b, 0xaf, 0x76, 0x75, 0xb8,
+	0x2b, 0x27, 0xee, 0x13, 0xf4, 0xb6, 0x7d, 0x8a, 0x02, 0x05, 0x7a, 0xd1, 0xab, 0xf6, 0x71, 0x7a,
+	0xfa, 0x10, 0x2d, 0x50, 0x14, 0x28, 0x66, 0xc8, 0xdd, 0xe5, 0x4a, 0xb2, 0xad, 0xa0, 0x57, 0xda,
+	0xf9, 0xcd, 0x90, 0x1c, 0x0e, 0x87, 0x9c, 0x1f, 0x29, 0x68, 0x74, 0xc2, 0x20, 0x56, 0xa1, 0xef,
+	0x4b, 0xb5, 0x31, 0x50, 0x61, 0x1c, 0xb2, 0xe2, 0xe0, 0xb0, 0xf9, 0x4d, 0xcf, 0x8b, 0x8f, 0x86,
+	0x87, 0x1b, 0x9d, 0xb0, 0xff, 0xa8, 0x17, 0xf6, 0xc2, 0x47, 0xa4, 0x3a, 0x1c, 0x7e, 0x24, 0x89,
+	0x04, 0xfa, 0xd2, 0x4d, 0x9c, 0x75, 0x58, 0x7e, 0x2f, 0x7c, 0xaf, 0x2b, 0x62, 0xd9, 0x0e, 0xc4,
+	0xb1, 0x74, 0xe5, 0xf7, 0x43, 0x19, 0xc5, 0xac, 0x01, 0xa5, 0x77, 0xee, 0x1e, 0x2f, 0xac, 0x15,
+	0xd6, 0xab, 0x2e, 0x7e, 0x3a, 0xff, 0x2e, 0xc0, 0xe5, 0x11, 0xd3, 0x68, 0x10, 0x06, 0x91, 0x64,
+	0x3f, 0x81, 0xf9, 0x76, 0x2c, 0x54, 0xdc, 0x8e, 0x45, 0x3c, 0x8c, 0xa8, 0xcd, 0xfc, 0xe6, 0x95,
+	0x8d, 0xc1, 0xe1, 0x46, 0xce, 0x4e, 0xab, 0x5d, 0xdb, 0x96, 0xfd, 0x18, 0xa0, 0x15, 0x9e, 0x18,
+	0x15, 0x2f, 0x9e, 0xdf, 0xd2, 0x32, 0x65, 0x3f, 0x82, 0xea, 0x4e, 0xd0, 0x35, 0xed, 0x4a, 0xe7,
+	0xb7, 0xcb, 0x2c, 0x71, 0xbc, 0x7d, 0x2f, 0xe8, 0x99, 0x76, 0x33, 0x17, 0x8c, 0x97, 0x99, 0x3a,
+	0x7f, 0x2e, 0xc0, 0xd2, 0x04, 0x1b, 0xc6, 0x61, 0xae, 0x25, 0xa3, 0x48, 0xf4, 0xa4, 0x89, 0x55,
+	0x22, 0xb2, 0x15, 0x98, 0xdd, 0x51, 0x2a, 0x54, 0x38, 0xad, 0xd2, 0x7a, 0xd5, 0x35, 0x12, 0x63,
+	0x30, 0x13, 0x7b, 0x7d, 0x49, 0x4e, 0x97, 0x5d, 0xfa, 0xc6, 0x68, 0x2b, 0xf1, 0x89, 0xfc, 0xa9,
+	0xba, 0xf8, 0xc9, 0x56, 0x01, 0x22, 0x1a, 0x61, 0x2b, 0xec, 0x4a, 0x5e, 0x26, 0x5b, 0x0b, 0x61,
+	0x37, 0xa0, 0x1c, 0x75, 0x42, 0x25, 0xf9, 0x2c, 0xcd, 0xa1, 0x4a, 0x73, 0x40, 0xc0, 0xd5, 0xb8,
+	0xf3, 0x16, 0xca, 0x24, 0x33, 0x07, 0x6a, 0x9d, 0x23, 0xd9, 0x39, 0x8e, 0xf6, 0x45, 0x14, 0xc9,
+	0x2e, 0xb9, 0x59, 0x76, 0x73, 0x58, 0x66, 0xf3, 0x52, 0x78, 0xbe, 0xec, 0xf2, 0xa2, 0x6d, 0xa3,
+	0x31, 0xa7, 0x06, 0xb0, 0x1f, 0x0e, 0x4c, 0x7e, 0x38, 0x4f, 0x60, 0x9e, 0x24, 0x93, 0x02, 0x75,
+	0x28, 0xee, 0x6e, 0x9b, 0x08, 0x14, 0x77, 0xb7, 0xd9, 0x32, 0x94, 0x0f, 0xc2, 0x63, 0x19, 0x50,
+	0x4f, 0x55, 0x57, 0x0b, 0xce, 0x0d, 0x58, 0x30, 0xa1, 0x35, 0x59, 0x36, 0xd2, 0xcc, 0xf9, 0x35,
+	0xd4, 0x13, 0x03, 0xd3, 0xf1, 0x35, 0x98, 0x79, 0x25, 0xfa, 0xd2, 0x24, 0x55, 0x05, 0xa7, 0x89,
+	0xb2, 0x4b, 0x28, 0x7b, 0x00, 0xd5, 0x3d, 0x11, 0xc5, 0x2f, 0x15, 0x9a, 0xe8, 0xec, 0x59, 0x48,
+	0x4c, 0x08, 0x74, 0x33, 0xbd, 0xb3, 0x0a, 0x35, 0x4a, 0xbd, 0xb3, 0x06, 0xbf, 0x04, 0x0b, 0x46,
+	0xaf, 0xc7, 0x76, 0xfe, 0x59, 0x83, 0x85, 0x2d, 0x25, 0x45, 0x9c, 0xee, 0x8a, 0x65, 0x28, 0x7f,
+	0xf0, 0xba, 0xf1, 0x91, 0x09, 0xa2, 0x16, 0x70, 0xa5, 0x5f, 0x4b, 0xaf, 0x77, 0x14, 0x9b, 0xb8,
+	0x19, 0x09, 0x57, 0xfa, 0x65, 0x18, 0x76, 0x93, 0x95, 0xc6, 0x6f, 0xb6, 0x0e, 0xb3, 0x94, 0x46,
+	0x98, 0x7c, 0xa5, 0xf5, 0xf9, 0xcd, 0x46, 0x9a, 0x7c, 0x6f, 0x07, 0xb1, 0x17, 0x06, 0x91, 0x6b,
+	0xf4, 0xec, 0x29, 0x5c, 0x69, 0x89, 0xcf, 0x07, 0x43, 0x15, 0x44, 0x07, 0xe1, 0x1b, 0xf9, 0x39,
+	0xc6, 0xf6, 0xed, 0x81, 0xf8, 0x14, 0x98, 0x74, 0x38, 0x4b, 0x8d, 0xab, 0x49, 0x7d, 0x1c, 0x78,
+	0x7d, 0x19, 0x0e, 0x63, 0x4a, 0x91, 0xb2, 0x9b, 0xc3, 0x30, 0x6f, 0xdd, 0xa1, 0x2f, 0x23, 0x19,
+	0xf3, 0x39, 0x9d, 0xb7, 0x46, 0x44, 0xaf, 0xdb, 0x52, 0x76, 0x79, 0x65, 0xad, 0xb0, 0x5e, 0x72,
+	0xe9, 0x1b, 0xad, 0x3f, 0x28, 0x31, 0x18, 0xc8, 0x2e, 0xaf, 0xae, 0x15, 0xd6, 0x2b, 0x6e, 0x22,
+	0xe2, 0x58, 0xaf, 0xc5, 0x6f, 0x85, 0xea, 0x6e, 0x8b, 0x3e, 0x6e, 0x02, 0xd0, 0x63, 0xd9, 0x18,
+	0x7b, 0x08, 0x8b, 0xed, 0x23, 0xe5, 0x05, 0xc7, 0x3b, 0x27, 0x52, 0x9d, 0xbe, 0x21, 0x9f, 0xf9,
+	0x3c, 0x19, 0x8e, 0x2b, 0xd8, 0x63, 0x58, 0x7a, 0xee, 0xfb, 0xe1, 0xa7, 0x17, 0x61, 0xf7, 0x74,
+	0x2b, 0xf4, 0x7d, 0x2f, 0xc2, 0xb0, 0xf0, 0x1a, 0x8d, 0x3b, 0x49, 0xa5, 0xfb, 0x17, 0x4a, 0x76,
+	0x77, 0x7c, 0xaf, 0xef, 0x05, 0x02, 0xe3, 0xc8, 0x17, 0xc8, 0x7e, 0x5c, 0x41, 0xd1, 0x21, 0xf0,
+	0xb5, 0x14, 0x7e, 0x7c, 0xc4, 0xeb, 0x64, 0x98, 0xc3, 0x32, 0x9b, 0x3d, 0x19, 0xf4, 0xe2, 0x23,
+	0x7e, 0xc9, 0xb6, 0xd1, 0x18, 0xbb, 0x4b, 0xb9, 0xaa, 0x62, 0x2f, 0xe8, 0x99, 0x9e, 0x1a, 0x34,
+	0xa5, 0x11, 0x14, 0x77, 0x32, 0x2e, 0x8d, 0xb1, 0x59, 0xd4, 0x3b, 0x39, 0x43, 0xd0, 0x7b, 0xfd,
+	0xb5, 0x17, 0x46, 0xd1, 0xbe, 0x54, 0x18, 0x05, 0xce, 0x74, 0x74, 0xc6, 0x14, 0xf6, 0xa8, 0xc6,
+	0xb7, 0xa5, 0xfc, 0xa8, 0xc6, 0xbb, 0x26, 0x54, 0x92, 0xf4, 0xe0, 0xcb, 0x64, 0x91, 0xca, 0xe8,
+	0xd1, 0x81, 0x27, 0x0f, 0x95, 0x14, 0xc7, 0x52, 0xf1, 0xcb, 0xb4, 0xfc, 0x16, 0x82, 0xa7, 0x51,
+	0x4b, 0x0c, 0xf8, 0x8a, 0x3e, 0x8d, 0x5a, 0x62, 0x80, 0xf1, 0x68, 0x89, 0xc1, 0x2b, 0x19, 0x48,
+	0x25, 0xe2, 0x50, 0xf1, 0x2b, 0xa4, 0xca, 0x61, 0x6c, 0x0d, 0xe6, 0xd3, 0x14, 0x94, 0x8a, 0x73,
+	0x32, 0xb1, 0x21, 0xb4, 0x68, 0x79, 0x81, 0xd7, 0x1f, 0xf6, 0x11, 0xe5, 0x57, 0xc9, 0x2d, 0x1b,
+	0xc2, 0x58, 0xa4, 0x0d, 0x76, 0x83, 0x58, 0xaa, 0x13, 0xe1, 0xf3, 0xa6, 0x8e, 0xc5, 0x98, 0x82,
+	0xdd, 0x86, 0x05, 0x04, 0xf7, 0x7d, 0xd1, 0x91, 0x7d, 0x19, 0xc4, 0xfc, 0x2b, 0x1a, 0x33, 0x0f,
+	0x62, 0x3e, 0x21, 0xd0, 0xf2, 0x82, 0xd7, 0x52, 0x74, 0xb7, 0xbd, 0x28, 0x16, 0x41, 0x47, 0xf2,
+	0x6b, 0xd4, 0xeb, 0x24, 0x15, 0xdb, 0xd6, 0x33, 0xf9, 0x40, 0xbb, 0x38, 0xe2, 0xd7, 0x69, 0xa3,
+	0x3a, 0xb8, 0x51, 0x73, 0xa7, 0xc1, 0x86, 0x65, 0xb4, 0x13, 0xc4, 0xea, 0xd4, 0xb5, 0x9b, 0xa1,
+	0x77, 0xaf, 0x54, 0xf8, 0x29, 0x3e, 0xda, 0x97, 0x8a, 0xe6, 0xbb, 0x4a, 0x23, 0xe6, 0x41, 0xda,
+	0x3f, 0xc3, 0xa0, 0xa7, 0x4e, 0x4d, 0x7e, 0xdc, 0x30, 0xfb, 0xc7, 0xc2, 0xb0, 0x27, 0x13, 0x24,
+	0xb3, 0xe4, 0x6b, 0xba, 0xa7, 0x1c, 0x48, 0x2b, 0x1e, 0x9e, 0xc8, 0x16, 0xd6, 0x8b, 0x9b, 0x14,
+	0x88, 0x54, 0xc6, 0x51, 0xde, 0xd3, 0x66, 0x71, 0x45, 0xd7, 0x1b, 0x46, 0xdc, 0xd1, 0xa3, 0xd8,
+	0x58, 0x66, 0xd3, 0x92, 0xb1, 0xf2, 0x3a, 0xfc, 0x96, 0x5e, 0x63, 0x1b, 0xc3, 0x31, 0xf0, 0x00,
+	0x79, 0x21, 0x82, 0x63, 0x7e, 0x5b, 0x67, 0x55, 0x22, 0xa3, 0x97, 0xf8, 0xbd, 0x1b, 0x74, 0x94,
+	0x5e, 0x8d, 0x3b, 0xda, 0xcb, 0x1c, 0x48, 0x6b, 0x26, 0x3c, 0x7f, 0xa8, 0xe4, 0x7e, 0xe8, 0x7b,
+	0x9d, 0x53, 0x7e, 0xd7, 0xac, 0x99, 0x0d, 0x52, 0xa6, 0x88, 0xcf, 0x06, 0x8b, 0xf8, 0xd7, 0x26,
+	0x53, 0x32, 0x08, 0x3d, 0x69, 0x77, 0x64, 0x20, 0x94, 0x17, 0xf2, 0x75, 0x3d, 0xdb, 0x44, 0xc6,
+	0x13, 0x8c, 0xa2, 0x70, 0x8f, 0xf0, 0x99, 0x24, 0x02, 0x3b, 0x41, 0x77, 0x2b, 0x0c, 0xba, 0x1e,
+	0x1d, 0x0f, 0xf7, 0xf5, 0xec, 0x6c, 0x0c, 0x7d, 0x6b, 0x0f, 0xd5, 0x89, 0x77, 0x12, 0xaa, 0xad,
+	0x70, 0x18, 0xc4, 0xfc, 0x81, 0x9e, 0x41, 0x0e, 0xc4, 0x9e, 0x0e, 0x84, 0xea, 0xc9, 0xd8, 0x2c,
+	0xc6, 0x43, 0x1d, 0x4b, 0x1b, 0x6b, 0xfe, 0x0c, 0x1a, 0xa3, 0xc9, 0x81, 0xbb, 0xea, 0x58, 0x9e,
+	0x26, 0x8c, 0xea, 0x58, 0x9e, 0x62, 0x35, 0x39, 0x11, 0xfe, 0x50, 0x9a, 0xb2, 0xa1, 0x85, 0x9f,
+	0x16, 0x9f, 0x16, 0x9c, 0x35, 0xa8, 0x27, 0xa9, 0x36, 0xb9, 0xc0, 0x3a, 0x2e, 0x2c, 0x3d, 0xef,
+	0x76, 0xb3, 0x3a, 0x37, 0xb9, 0xa6, 0x61, 0x81, 0x4c, 0x6d, 0xce, 0x28, 0x90, 0xe9, 0xa7, 0xf3,
+	0xff, 0xb0, 0x9c, 0xef, 0x33, 0xab, 0xc1, 0xbd, 0x89, 0x35, 0x18, 0x51, 0xe7, 0x1d, 0x5c, 0xde,
+	0xf3, 0xa2, 0x38, 0x6d, 0x76, 0x56, 0x71, 0xc7, 0xe9, 0xee, 0x79, 0x7d, 0x2f, 0xa9, 0x92, 0x5a,
+	0xc0, 0xe2, 0xf9, 0xf6, 0xe3, 0x47, 0xac, 0x43, 0xba, 0x4c, 0x1a, 0xc9, 0x79, 0x07, 0x2b, 0xa3,
+	0xdd, 0x1a, 0x77, 0xee, 0xc0, 0xac, 0x46, 0x78, 0x61, 0xad, 0x34, 0x3e, 0x21, 0xa3, 0xc4, 0xe1,
+	0xf4, 0x2a, 0x9a, 0xe1, 0x48, 0x70, 0x7e, 0x03, 0x8b, 0x2f, 0x64, 0x14, 0x13, 0x35, 0x4a, 0x3d,
+	0xc5, 0x64, 0xc2, 0xe2, 0x98, 0x31, 0xde, 0x54, 0xce, 0x4a, 0x7e, 0x71, 0x72, 0xc9, 0x2f, 0xd9,
+	0x25, 0xdf, 0x79, 0x06, 0xcc, 0xee, 0x3e, 0xf3, 0x58, 0x23, 0xb6, 0xc7, 0xed, 0xd0, 0x0f, 0x09,
+	0x75, 0x8d, 0xd2, 0x79, 0x0d, 0xf5, 0x9d, 0x80, 0xe2, 0x7f, 0x56, 0x08, 0xef, 0xc2, 0xac, 0x2b,
+	0xa3, 0xa1, 0x1f, 0x9b, 0xb5, 0xac, 0xa7, 0x6b, 0x41, 0xa8, 0x6b, 0xb4, 0xce, 0x22, 0x5c, 0x4a,
+	0x7b, 0x32, 0x64, 0x66, 0x01, 0xe6, 0x91, 0xce, 0x26, 0xfc, 0x6d, 0x1d, 0x6a, 0x5a, 0x34, 0x2e,
+	0x72, 0x98, 0x7b, 0x2f, 0x15, 0x6e, 0xf5, 0x84, 0xc7, 0x1a, 0xd1, 0xf9, 0x43, 0x01, 0x6a, 0x36,
+	0x41, 0xc1, 0xed, 0xf5, 0x26, 0x49, 0x87, 0xaa, 0x4b, 0xdf, 0xc9, 0x75, 0xa1, 0x98, 0x5e, 0x17,
+	0x8c, 0xeb, 0xa5, 0xd4, 0xf5, 0x26, 0x54, 0xf0, 0x90, 0x3d, 0x38, 0x1d, 0x48, 0xc3, 0x73, 0x53,
+	0x99, 0x8e, 0x15, 0xe1, 0xf9, 0xa4, 0x2b, 0x6b, 0x5d, 0x22, 0x63, 0xfc, 0xdb, 0xdf, 0x0f, 0x45,
+	0x97, 0x58, 0x4c, 0xd5, 0xd5, 0x82, 0xf3, 0x8f, 0xba, 0xe6, 0x85, 0x63, 0x11, 0x5a, 0x81, 0x59,
+	0xeb, 0x32, 0x51, 0x75, 0x8d, 0x94, 0x2d, 0x63, 0x69, 0xf2, 0x32, 0xce, 0xe4, 0x98, 0xdb, 0x34,
+	0x0c, 0x2a, 0x39, 0x65, 0x2a, 0xd6, 0x29, 0x73, 0x0e, 0x67, 0xab, 0x9e, 0xcf, 0xd9, 0x9e, 0xc2,
+	0x15, 0xc2, 0xdb, 0x5e, 0xd0, 0x91, 0xc4, 0x59, 0xd3, 0x96, 0x9a, 0x52, 0x9d, 0xa5, 0xb6, 0x99,
+	0xdc, 0xfc, 0x64, 0x26, 0x57, 0x9b, 0xcc, 0xe4, 0x16, 0xce, 0x67, 0x72, 0xf5, 0x69, 0x99, 0xdc,
+	0xa5, 0x2f, 0x64, 0x72, 0x8d, 0x2f, 0x64, 0x72, 0x8b, 0xd3, 0x32, 0x39, 0x36, 0x05, 0x93, 0x5b,
+	0x9a, 0x8a, 0xc9, 0x2d, 0x4f, 0xc1, 0xe4, 0x2e, 0x4f, 0xc7, 0xe4, 0x56, 0xa6, 0x67, 0x72, 0x57,
+	0x2e, 0x64, 0x72, 0xfc, 0x5c, 0x26, 0x77, 0x75, 0x8c, 0xc9, 0x65, 0xe7, 0x45, 0xf3, 0xbc, 0xf3,
+	0x22, 0x61, 0x7c, 0x5f, 0x65, 0x8c, 0xef, 0x1e, 0x40, 0x9a, 0x62, 0x11, 0xbf, 0xb6, 0x56, 0x4a,
+	0x2e, 0x99, 0xfb, 0xa1, 0x17, 0xc4, 0xae, 0xa5, 0x1c, 0x25, 0x7e, 0xd7, 0x2f, 0x24, 0x7e, 0xab,
+	0x53, 0x12, 0xbf, 0x1b, 0x53, 0x13, 0xbf, 0xb5, 0x2f, 0x20, 0x7e, 0x37, 0xcf, 0x26, 0x7e, 0xcf,
+	0xf2, 0xc4, 0xcf, 0xa1, 0x59, 0x5f, 0x4d, 0x62, 0xf6, 0xa5, 0x7c, 0xef, 0xd6, 0x34, 0x7c, 0xef,
+	0xf6, 0x34, 0x7c, 0xef, 0xce, 0x45, 0x7c, 0xef, 0xee, 0x05, 0x7c, 0xef, 0xeb, 0x29, 0xf8, 0xde,
+	0xfa, 0x05, 0x7c, 0xef, 0xde, 0x45, 0x7c, 0xef, 0xfe, 0x54, 0x7c, 0xef, 0xc1, 0x14, 0x7c, 0xef,
+	0xe1, 0xf9, 0x7c, 0xef, 0x9b, 0x71, 0xbe, 0xf7, 0x2a, 0x14, 0x3e, 0xdf, 0xd0, 0x27, 0x31, 0x7e,
+	0xb3, 0x6b, 0x50, 0xc5, 0x5f, 0xbd, 0x6d, 0x1e, 0x51, 0x7f, 0x19, 0x30, 0xc6, 0x06, 0x1f, 0x4f,
+	0xc3, 0x06, 0xff, 0x6f, 0x1a, 0x36, 0xb8, 0x39, 0xce, 0x06, 0xd9, 0x23, 0x98, 0x6b, 0x77, 0x94,
+	0x37, 0x88, 0x23, 0xfe, 0x84, 0x52, 0xea, 0x72, 0x9a, 0x52, 0x06, 0xd7, 0xe9, 0x94, 0x58, 0xfd,
+	0xaf, 0xf4, 0xb1, 0xf9, 0x12, 0x6a, 0x76, 0xc7, 0x13, 0xda, 0xae, 0xd9, 0x6d, 0xe7, 0x37, 0x41,
+	0x3f, 0x1f, 0x61, 0x13, 0x9b, 0x86, 0xae, 0xc2, 0xac, 0x06, 0x71, 0x2c, 0x4c, 0x2e, 0x4d, 0x60,
+	0xaa, 0xae, 0x16, 0x9c, 0xdf, 0x17, 0x00, 0xb2, 0xd3, 0x84, 0x6a, 0x8b, 0x17, 0x04, 0x52, 0x25,
+	0x66, 0x89, 0x88, 0x2b, 0xb4, 0x8d, 0x0f, 0x5c, 0x45, 0x3a, 0x7d, 0xe9, 0x1b, 0x6b, 0xaf, 0x2b,
+	0x45, 0x14, 0x06, 0x86, 0x24, 0x18, 0x89, 0xdd, 0x32, 0x0f, 0x57, 0xe6, 0x75, 0x6e, 0x84, 0x2b,
+	0x69, 0x1d, 0x15, 0x3d, 0x11, 0x1c, 0x7b, 0x41, 0x8f, 0x97, 0xf5, 0x50, 0x46, 0x74, 0xfe, 0x56,
+	0x80, 0x6a, 0x6a, 0x8e, 0x83, 0xa0, 0x83, 0x29, 0x45, 0x30, 0x52, 0x8e, 0xf1, 0x15, 0xcf, 0x62,
+	0x7c, 0x53, 0x51, 0x05, 0x7c, 0xe9, 0xa2, 0x24, 0xd3, 0x8f, 0x32, 0x5a, 0xc0, 0xf4, 0xc3, 0x15,
+	0xdc, 0x11, 0xb1, 0x0c, 0x0c, 0x7b, 0xc8, 0x00, 0xec, 0xcb, 0xa4, 0xcb, 0x9c, 0xee, 0x4b, 0x4b,
+	0xce, 0x9f, 0x8a, 0x16, 0x5d, 0xc7, 0xa0, 0x51, 0x05, 0xd1, 0x6f, 0x4d, 0xf4, 0xcd, 0xae, 0x9b,
+	0x27, 0xa5, 0xe2, 0xe8, 0x81, 0x4c, 0x30, 0xbb, 0x99, 0xbe, 0x2e, 0x95, 0x32, 0x03, 0x42, 0xec,
+	0x67, 0xa5, 0xb3, 0x88, 0xc6, 0xcc, 0xf9, 0x44, 0xe3, 0x16, 0xcc, 0x69, 0x32, 0x10, 0xf1, 0x72,
+	0xd6, 0xbb, 0x1e, 0x3e, 0xd1, 0xe0, 0xbb, 0xe4, 0x07, 0xe1, 0xfb, 0x11, 0x9f, 0x1d, 0x35, 0xd1,
+	0x38, 0xbb, 0xaf, 0x23, 0xb3, 0x1b, 0xcb, 0x7e, 0xc4, 0xe7, 0xc8, 0xa8, 0x86, 0x46, 0x09, 0xe8,
+	0x66, 0x6a, 0x8c, 0x22, 0x26, 0xda, 0x5b, 0xd5, 0x95, 0x8a, 0x57, 0x68, 0x9d, 0x33, 0xc0, 0xf9,
+	0x39, 0x54, 0x12, 0x53, 0x1c, 0x96, 0x46, 0x31, 0x77, 0x14, 0x7b, 0x58, 0xfa, 0xa1, 0x60, 0x22,
+	0xbd, 0xd4, 0x8b, 0x4d, 0xdf, 0xce, 0x2d, 0xd3, 0x88, 0xd5, 0xa0, 0xf0, 0xad, 0x09, 0x73, 0xe1,
+	0x5b, 0x94, 0xbe, 0x33, 0x7b, 0xaa, 0xf0, 0x9d, 0xf3, 0x9f, 0x12, 0x94, 0x29, 0x74, 0x63, 0x54,
+	0x33, 0xe1, 0xc1, 0xc5, 0x71, 0x1e, 0x5c, 0xca, 0x78, 0xf0, 0x75, 0x98, 0x41, 0x92, 0x63, 0x9e,
+	0xfb, 0xec, 0x15, 0x43, 0x58, 0xa7, 0x15, 0x55, 0x82, 0x72, 0x92, 0x56, 0x28, 0xe1, 0x84, 0xb6,
+	0xa5, 0x88, 0x8f, 0xec, 0xf7, 0x5d, 0x02, 0x5c, 0x8d, 0xeb, 0xeb, 0x8d, 0x1f, 0x2a, 0xf3, 0x7c,
+	0xa7, 0x85, 0x1c, 0xcb, 0xae, 0x9c, 0xc3, 0xb2, 0xab, 0x23, 0x2c, 0x9b, 0xc3, 0xdc, 0x1e, 0xa6,
+	0x66, 0xe7, 0x94, 0xe8, 0x66, 0xd5, 0x4d, 0xc4, 0x8c, 0x7f, 0xcf, 0x5b, 0xfc, 0x3b, 0x57, 0x18,
+	0x6a, 0x23, 0x85, 0xe1, 0x31, 0x2c, 0x6d, 0xe1, 0x9d, 0xa2, 0x33, 0x8c, 0xbd, 0x13, 0x99, 0x1e,
+	0xea, 0x0b, 0xba, 0xee, 0x4e, 0x50, 0x51, 0x29, 0x09, 0x63, 0xe1, 0xa7, 0xb6, 0x75, 0x53, 0x4a,
+	0x6c, 0x10, 0x8b, 0x04, 0x25, 0xa4, 0x96, 0x89, 0x76, 0x56, 0x5d, 0x1b, 0x62, 0x1b, 0xfa, 0xdf,
+	0x04, 0x43, 0x79, 0x1a, 0x19, 0xe5, 0xc9, 0x50, 0xd7, 0xb2, 0xc8, 0xef, 0x52, 0x36, 0xb2, 0x4b,
+	0x7f, 0x31, 0x53, 0x59, 0x6c, 0x30, 0xa7, 0x05, 0x56, 0xc0, 0xc5, 0x30, 0x4a, 0xee, 0x3d, 0x5a,
+	0x48, 0x37, 0x69, 0xd1, 0xda, 0xa4, 0x4d, 0xa8, 0xfc, 0xd2, 0xf3, 0x7d, 0xd9, 0x7d, 0x71, 0x6a,
+	0x32, 0x21, 0x95, 0x9d, 0xdf, 0x15, 0x6c, 0x1f, 0xf5, 0x25, 0xe2, 0x24, 0xbd, 0x4b, 0xe1, 0x37,
+	0x0e, 0x44, 0xff, 0xb3, 0x98, 0xd3, 0x52, 0x0b, 0x88, 0xd2, 0x1f, 0x08, 0xa6, 0x47, 0x2d, 0x20,
+	0x01, 0x6c, 0x67, 0x7f, 0x13, 0xe8, 0x0d, 0x6c, 0x21, 0xe8, 0x4a, 0x72, 0xc5, 0x4b, 0x6e, 0x56,
+	0x89, 0xbc, 0xf9, 0x97, 0x19, 0x80, 0xad, 0xf4, 0x2f, 0x24, 0x76, 0x17, 0x4a, 0xfb, 0xe1, 0x80,
+	0xd5, 0x75, 0x86, 0x26, 0x0f, 0xfd, 0xcd, 0x4b, 0xa9, 0xac, 0x9b, 0xb1, 0x47, 0xc9, 0x0d, 0x8b,
+	0x2d, 0xa2, 0x2a, 0xf7, 0xa0, 0xdf, 0x64, 0x36, 0x64, 0x1a, 0x3c, 0x84, 0x32, 0x51, 0x5a, 0xd6,
+	0x30, 0xca, 0xf4, 0x09, 0xbe, 0xb9, 0x68, 0x21, 0x59, 0xf7, 0xfa, 0xe9, 0x43, 0x77, 0x9f, 0x7b,
+	0x71, 0x6b, 0x32, 0x1b, 0x32, 0x0d, 0x9e, 0x43, 0xcd, 0x7e, 0xb5, 0x60, 0xf4, 0x77, 0xce, 0x84,
+	0xb7, 0x91, 0x26, 0x1f, 0x57, 0x98, 0x2e, 0x5e, 0x41, 0x3d, 0xff, 0xd6, 0xc0, 0x88, 0xf4, 0x4d,
+	0x7c, 0xd6, 0x68, 0x36, 0x27, 0xa9, 0x4c, 0x47, 0x9b, 0x30, 0x67, 0xee, 0xdd, 0x8c, 0x5c, 0xcd,
+	0x5f, 0xe7, 0x9b, 0x4b, 0x39, 0xcc, 0xb4, 0xb9, 0x07, 0x33, 0x78, 0x13, 0x67, 0x3a, 0xd0, 0xd9,
+	0x15, 0xbd, 0xd9, 0xc8, 0x00, 0x63, 0xba, 0x0d, 0x0b, 0xb9, 0x7f, 0xe0, 0x18, 0x4d, 0x69, 0xd2,
+	0xff, 0x77, 0xcd, 0xab, 0x13, 0x34, 0xa6, 0x97, 0x67, 0x00, 0xd9, 0x1b, 0x05, 0x23, 0x2e, 0x32,
+	0xf6, 0x24, 0xd2, 0x5c, 0x19, 0x85, 0x75, 0xe3, 0x17, 0x8d, 0x7f, 0xfd, 0x7d, 0xb5, 0xf0, 0xc7,
+	0x1f, 0x56, 0x0b, 0x7f, 0xfd, 0x61, 0xb5, 0xf0, 0xab, 0xe2, 0xe0, 0xf0, 0x70, 0x96, 0xfe, 0x48,
+	0x7c, 0xf2, 0xdf, 0x01, 0x00, 0xca, 0x96, 0x3a, 0x7a, 0x8f, 0x1c, 0x00, 0x00,
 }
@@ -38,17 +38,22 @@ func (s *Snake) DefaultMove() {
 	head := s.Head()
 	neck := s.Body[1]
 
+	// On a wrapped board the neck can be on the opposite edge from the head,
+	// in which case the snake is heading the other way.
+	wrappedX := head.X-neck.X > 1 || neck.X-head.X > 1
+	wrappedY := head.Y-neck.Y > 1 || neck.Y-head.Y > 1
+
 	if head.X == neck.X && head.Y == neck.Y {
 		// this is the case when the game starts up and all 3 segments are still on the same point
 		s.Move("up")
 	} else if head.X == neck.X {
-		if head.Y > neck.Y {
+		if (head.Y > neck.Y) != wrappedY {
 			s.Move("down")
 		} else {
 			s.Move("up")
 		}
 	} else if head.Y == neck.Y {
-		if head.X > neck.X {
+		if (head.X > neck.X) != wrappedX {
 			s.Move("right")
 		} else {
 			s.Move("left")
@@ -56,6 +61,17 @@ func (s *Snake) DefaultMove() {
 	}
 }
 
+// WrapHead moves the head back onto a width x height board if it has moved off
+// one of the edges, so the snake enters the board again from the opposite edge.
+func (s *Snake) WrapHead(width, height int32) {
+	h := s.Head()
+	if h == nil || width <= 0 || height <= 0 {
+		return
+	}
+	h.X = ((h.X % width) + width) % width
+	h.Y = ((h.Y % height) + height) % height
+}
+
 // Head returns the first point in the body
 func (s *Snake) Head() *Point {
 	if len(s.Body) == 0 {
@@ -84,6 +84,22 @@ func TestSnake_DefaultMove(t *testing.T) {
 			},
 			Expected: &Point{X: 6, Y: 5},
 		},
+		{
+			// wrapped off the left edge of a 10 wide board
+			Body: []*Point{
+				{X: 9, Y: 5},
+				{X: 0, Y: 5},
+			},
+			Expected: &Point{X: 8, Y: 5},
+		},
+		{
+			// wrapped off the bottom edge of a 10 high board
+			Body: []*Point{
+				{X: 5, Y: 0},
+				{X: 5, Y: 9},
+			},
+			Expected: &Point{X: 5, Y: 1},
+		},
 	}
 
 	for _, test := range tests {
@@ -95,6 +111,27 @@ func TestSnake_DefaultMove(t *testing.T) {
 	}
 }
 
+func TestSnake_WrapHead(t *testing.T) {
+	tests := []struct {
+		Head     *Point
+		Expected *Point
+	}{
+		{Head: &Point{X: -1, Y: 5}, Expected: &Point{X: 9, Y: 5}},
+		{Head: &Point{X: 10, Y: 5}, Expected: &Point{X: 0, Y: 5}},
+		{Head: &Point{X: 5, Y: -1}, Expected: &Point{X: 5, Y: 7}},
+		{Head: &Point{X: 5, Y: 8}, Expected: &Point{X: 5, Y: 0}},
+		{Head: &Point{X: 5, Y: 5}, Expected: &Point{X: 5, Y: 5}},
+	}
+
+	for _, test := range tests {
+		s := &Snake{
+			Body: []*Point{test.Head},
+		}
+		s.WrapHead(10, 8)
+		require.Equal(t, test.Expected, s.Head())
+	}
+}
+
 func TestSnake_Tail(t *testing.T) {
 	s := &Snake{
 		Body: []*Point{
@@ -132,6 +132,78 @@ func (rs *Store) SetGameStatus(c context.Context, id string, status rules.GameSt
 	return nil
 }
 
+// SetGameResult records the result of a game once it has ended. Solo scores
+// are also kept per snake URL so they can be listed without scanning games.
+// Only the best score on each board size is kept, and it never expires so a
+// snake's best scores outlive its old games.
+func (rs *Store) SetGameResult(c context.Context, id string, result *pb.GameResult) error {
+	resultBytes, err := proto.Marshal(result)
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal game result")
+	}
+	if result.Score == nil {
+		err = rs.client.HSet(gameKey(id), "result", resultBytes).Err()
+		if err != nil {
+			return errors.Wrap(err, "unexpected redis error when setting game result")
+		}
+		return nil
+	}
+
+	scoreBytes, err := proto.Marshal(result.Score)
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal solo score")
+	}
+	sk := scoresKey(result.Score.SnakeURL)
+	field := scoreField(result.Score)
+	err = rs.client.Watch(func(tx *redis.Tx) error {
+		better := true
+		best, err := tx.HGet(sk, field).Bytes()
+		if err != nil && err != redis.Nil {
+			return err
+		}
+		if err == nil {
+			bestScore := &pb.SoloScore{}
+			if err := proto.Unmarshal(best, bestScore); err != nil {
+				return errors.Wrap(err, "unable to unmarshal solo score")
+			}
+			better = rules.BetterSoloScore(result.Score, bestScore)
+		}
+
+		_, err = tx.Pipelined(func(pipe redis.Pipeliner) error {
+			pipe.HSet(gameKey(id), "result", resultBytes)
+			if better {
+				pipe.HSet(sk, field, scoreBytes)
+			}
+			return nil
+		})
+		return err
+	}, sk)
+	if err != nil {
+		return errors.Wrap(err, "unexpected redis error when setting game result")
+	}
+
+	return nil
+}
+
+// ListSoloScores lists the best score of the single player games played by a
+// snake URL on each board size.
+func (rs *Store) ListSoloScores(c context.Context, snakeURL string) ([]*pb.SoloScore, error) {
+	values, err := rs.client.HVals(scoresKey(snakeURL)).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "unexpected redis error when listing solo scores")
+	}
+
+	scores := []*pb.SoloScore{}
+	for _, v := range values {
+		score := &pb.SoloScore{}
+		if err := proto.Unmarshal([]byte(v), score); err != nil {
+			return nil, errors.Wrap(err, "unable to unmarshal solo score")
+		}
+		scores = append(scores, score)
+	}
+	return scores, nil
+}
+
 // CreateGame will insert a game with the default game frames.
 func (rs *Store) CreateGame(c context.Context, game *pb.Game, frames []*pb.GameFrame) error {
 	if game.ID == "" {
@@ -242,9 +314,12 @@ func (rs *Store) GetGame(c context.Context, id string) (*pb.Game, error) {
 	pipe := rs.client.TxPipeline()
 	gameData := pipe.HGet(gk, "state")
 	gameStatus := pipe.HGet(gk, "status")
+	gameResult := pipe.HGet(gk, "result")
 
+	// The result is only set once the game has ended, so a missing field is
+	// expected.
 	_, err := pipe.Exec()
-	if err != nil {
+	if err != nil && err != redis.Nil {
 		return nil, errors.Wrap(err, "unexpected redis error")
 	}
 	var game pb.Game
@@ -258,6 +333,13 @@ func (rs *Store) GetGame(c context.Context, id string) (*pb.Game, error) {
 	}
 	game.Status = gameStatus.Val()
 
+	if resultBytes, err := gameResult.Bytes(); err == nil {
+		game.Result = &pb.GameResult{}
+		if err := proto.Unmarshal(resultBytes, game.Result); err != nil {
+			return nil, errors.Wrap(err, "unable to unmarshal game result")
+		}
+	}
+
 	return &game, nil
 }
 
@@ -301,6 +383,17 @@ func framesKey(gameID string) string {
 	return fmt.Sprintf("game:%s:frames", gameID)
 }
 
+// generates the redis key for the solo scores of a snake URL
+func scoresKey(snakeURL string) string {
+	return fmt.Sprintf("scores:%s", snakeURL)
+}
+
+// generates the field a solo score is kept under in its scores key, one for
+// each board size
+func scoreField(score *pb.SoloScore) string {
+	return fmt.Sprintf("%dx%d", score.Width, score.Height)
+}
+
 // generates the redis key for game lock state
 func gameLockKey(gameID string) string {
 	return fmt.Sprintf("game:%s:locks", gameID)
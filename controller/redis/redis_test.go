@@ -6,6 +6,7 @@ import (
 	"io"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/battlesnakeio/engine/controller"
 	"github.com/battlesnakeio/engine/controller/pb"
@@ -114,6 +115,66 @@ func TestSetGameStatus(t *testing.T) {
 	assert.Equal(t, string(status), game.GetStatus())
 }
 
+// SetGameResult records the result of a game once it has ended.
+func TestSetGameResult(t *testing.T) {
+
+	// Add a game
+	game := &pb.Game{
+		ID:     uuid.NewV4().String(),
+		Status: "old",
+	}
+	err := store.CreateGame(context.Background(), game, nil)
+	assert.NoError(t, err, "no error for creating games")
+
+	// No result until one is set
+	game, err = store.GetGame(context.Background(), game.ID)
+	assert.NoError(t, err)
+	assert.Nil(t, game.Result)
+
+	// Set a result
+	result := &pb.GameResult{Draw: true, Reason: rules.ResultReasonMaxTurns}
+	err = store.SetGameResult(context.Background(), game.ID, result)
+	assert.NoError(t, err)
+
+	// Validate the result is present
+	game, _ = store.GetGame(context.Background(), game.ID)
+	assert.Equal(t, result, game.Result)
+}
+
+func TestListSoloScores(t *testing.T) {
+	url := "http://" + uuid.NewV4().String()
+
+	// No scores until a solo game ends
+	scores, err := store.ListSoloScores(context.Background(), url)
+	assert.NoError(t, err)
+	assert.Empty(t, scores)
+
+	// End a solo game
+	game := &pb.Game{ID: uuid.NewV4().String()}
+	err = store.CreateGame(context.Background(), game, nil)
+	assert.NoError(t, err, "no error for creating games")
+	score := &pb.SoloScore{GameID: game.ID, SnakeURL: url, Width: 11, Height: 11, Turns: 42}
+	err = store.SetGameResult(context.Background(), game.ID, &pb.GameResult{Score: score})
+	assert.NoError(t, err)
+
+	// Validate the score is listed
+	scores, err = store.ListSoloScores(context.Background(), url)
+	assert.NoError(t, err)
+	assert.Equal(t, []*pb.SoloScore{score}, scores)
+
+	// Best scores don't expire with the games
+	assert.Equal(t, time.Duration(0), server.TTL("scores:"+url))
+
+	// Only the best score on each board size is kept
+	worse := &pb.SoloScore{GameID: "worse", SnakeURL: url, Width: 11, Height: 11, Turns: 10}
+	assert.NoError(t, store.SetGameResult(context.Background(), game.ID, &pb.GameResult{Score: worse}))
+	better := &pb.SoloScore{GameID: "better", SnakeURL: url, Width: 11, Height: 11, Turns: 50}
+	assert.NoError(t, store.SetGameResult(context.Background(), game.ID, &pb.GameResult{Score: better}))
+	scores, err = store.ListSoloScores(context.Background(), url)
+	assert.NoError(t, err)
+	assert.Equal(t, []*pb.SoloScore{better}, scores)
+}
+
 // Test Create/Get games
 func TestCreateGame(t *testing.T) {
 
@@ -231,6 +231,45 @@ func (s *Store) SetGameStatus(
 	})
 }
 
+// SetGameResult records the result of a game once it has ended.
+func (s *Store) SetGameResult(
+	ctx context.Context, id string, result *pb.GameResult) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return s.transact(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `update games set value = jsonb_set(value, '{"Result"}', $2::jsonb) where id = $1;`, id, string(data))
+		return err
+	})
+}
+
+// ListSoloScores lists the scores of the single player games played by a
+// snake URL.
+func (s *Store) ListSoloScores(ctx context.Context, snakeURL string) ([]*pb.SoloScore, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT value->'Result'->'Score' FROM games
+		WHERE value->'Result'->'Score'->>'SnakeURL' = $1`, snakeURL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	scores := []*pb.SoloScore{}
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		score := &pb.SoloScore{}
+		if err := json.Unmarshal(data, score); err != nil {
+			return nil, err
+		}
+		scores = append(scores, score)
+	}
+	return scores, rows.Err()
+}
+
 // CreateGame will insert a game with the default game frames.
 func (s *Store) CreateGame(
 	ctx context.Context, g *pb.Game, frames []*pb.GameFrame) error {
@@ -42,6 +42,11 @@ type Store interface {
 	// SetGameStatus is used to set a specific game status. This operation
 	// should be atomic.
 	SetGameStatus(c context.Context, id string, status rules.GameStatus) error
+	// SetGameResult records the result of a game once it has ended.
+	SetGameResult(c context.Context, id string, result *pb.GameResult) error
+	// ListSoloScores lists the scores of the single player games played by a
+	// snake URL.
+	ListSoloScores(c context.Context, snakeURL string) ([]*pb.SoloScore, error)
 	// CreateGame will insert a game with the default game frames.
 	CreateGame(context.Context, *pb.Game, []*pb.GameFrame) error
 	// PushGameFrame will push a game frame onto the list of frames.
@@ -193,6 +198,28 @@ func (in *inmem) SetGameStatus(ctx context.Context, id string, status rules.Game
 	return ErrNotFound
 }
 
+func (in *inmem) SetGameResult(ctx context.Context, id string, result *pb.GameResult) error {
+	in.lock.Lock()
+	defer in.lock.Unlock()
+	if g, ok := in.games[id]; ok {
+		g.Result = result
+		return nil
+	}
+	return ErrNotFound
+}
+
+func (in *inmem) ListSoloScores(ctx context.Context, snakeURL string) ([]*pb.SoloScore, error) {
+	in.lock.Lock()
+	defer in.lock.Unlock()
+	scores := []*pb.SoloScore{}
+	for _, g := range in.games {
+		if g.Result != nil && g.Result.Score != nil && g.Result.Score.SnakeURL == snakeURL {
+			scores = append(scores, proto.Clone(g.Result.Score).(*pb.SoloScore))
+		}
+	}
+	return scores, nil
+}
+
 func (in *inmem) PushGameFrame(ctx context.Context, id string, g *pb.GameFrame) error {
 	in.lock.Lock()
 	defer in.lock.Unlock()
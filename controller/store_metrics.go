@@ -54,6 +54,16 @@ func (m *metrics) SetGameStatus(c context.Context, id string, status rules.GameS
 	return m.s.SetGameStatus(c, id, status)
 }
 
+func (m *metrics) SetGameResult(c context.Context, id string, result *pb.GameResult) error {
+	defer instrument("SetGameResult")()
+	return m.s.SetGameResult(c, id, result)
+}
+
+func (m *metrics) ListSoloScores(c context.Context, snakeURL string) ([]*pb.SoloScore, error) {
+	defer instrument("ListSoloScores")()
+	return m.s.ListSoloScores(c, snakeURL)
+}
+
 func (m *metrics) CreateGame(c context.Context, g *pb.Game, frames []*pb.GameFrame) error {
 	defer instrument("CreateGame")()
 	return m.s.CreateGame(c, g, frames)
@@ -103,6 +103,61 @@ func testStoreGameStatus(t *testing.T, s controller.Store) {
 	require.NotNil(t, err)
 }
 
+func testStoreGameResult(t *testing.T, s controller.Store) {
+	key := uuid.NewV4().String()
+	ctx := context.Background()
+
+	// Create a game, it has no result yet.
+	err := s.CreateGame(ctx, &pb.Game{
+		ID: key, Status: string(rules.GameStatusRunning)}, nil)
+	require.Nil(t, err)
+	g, err := s.GetGame(ctx, key)
+	require.Nil(t, err)
+	require.Nil(t, g.Result)
+
+	// Set the result.
+	result := &pb.GameResult{
+		Winners: []string{"snake_123"},
+		Reason:  rules.ResultReasonMaxTurns,
+	}
+	err = s.SetGameResult(ctx, key, result)
+	require.Nil(t, err)
+	g, err = s.GetGame(ctx, key)
+	require.Nil(t, err)
+	require.Equal(t, result, g.Result)
+}
+
+func testStoreSoloScores(t *testing.T, s controller.Store) {
+	url := "http://" + uuid.NewV4().String()
+	ctx := context.Background()
+
+	// No games played yet.
+	scores, err := s.ListSoloScores(ctx, url)
+	require.Nil(t, err)
+	require.Empty(t, scores)
+
+	score := &pb.SoloScore{SnakeURL: url, Width: 11, Height: 11, Turns: 42, FoodEaten: 3, Length: 6}
+	for _, result := range []*pb.GameResult{
+		{Reason: rules.ResultReasonEliminated, Score: score},
+		{Reason: rules.ResultReasonEliminated, Score: &pb.SoloScore{SnakeURL: url + "/other", Turns: 1}},
+		{Reason: rules.ResultReasonEliminated},
+	} {
+		key := uuid.NewV4().String()
+		err = s.CreateGame(ctx, &pb.Game{ID: key, Status: string(rules.GameStatusRunning)}, nil)
+		require.Nil(t, err)
+		if result.Score != nil {
+			result.Score.GameID = key
+		}
+		err = s.SetGameResult(ctx, key, result)
+		require.Nil(t, err)
+	}
+
+	// Only the scores for the snake URL are listed.
+	scores, err = s.ListSoloScores(ctx, url)
+	require.Nil(t, err)
+	require.Equal(t, []*pb.SoloScore{score}, scores)
+}
+
 func testStoreGames(t *testing.T, s controller.Store) {
 	key := uuid.NewV4().String()
 	ctx := context.Background()
@@ -212,6 +267,8 @@ func Suite(t *testing.T, s controller.Store, pretest func()) {
 	t.Run("LockExpiry", func(t *testing.T) { pretest(); testStoreLockExpiry(t, s) })
 	t.Run("Games", func(t *testing.T) { pretest(); testStoreGames(t, s) })
 	t.Run("GameStatus", func(t *testing.T) { pretest(); testStoreGameStatus(t, s) })
+	t.Run("GameResult", func(t *testing.T) { pretest(); testStoreGameResult(t, s) })
+	t.Run("SoloScores", func(t *testing.T) { pretest(); testStoreSoloScores(t, s) })
 	t.Run("GameFrames", func(t *testing.T) { pretest(); testStoreGameFrames(t, s) })
 	t.Run("ConcurrentWriters", func(t *testing.T) { pretest(); testStoreConcurrentWriters(t, s) })
 }
@@ -35,17 +35,33 @@ func init() { prometheus.MustRegister(snakeRequestsHistogramMetric) }
 var officialSnakeURL = os.Getenv("OFFICIAL_SNAKE_URL")
 
 type snakeResponse struct {
-	snake   *pb.Snake
-	data    []byte
-	err     error
-	latency time.Duration
+	snake      *pb.Snake
+	data       []byte
+	err        error
+	latency    time.Duration
+	statusCode int
 }
 
 type multiSnakeRequest struct {
-	url     string
-	timeout time.Duration
-	game    *pb.Game
-	frame   *pb.GameFrame
+	url        string
+	timeout    time.Duration
+	maxTimeout time.Duration
+	game       *pb.Game
+	frame      *pb.GameFrame
+}
+
+// timeoutFor returns how long a snake has to respond. In games played with a
+// time bank a snake has whatever is left in its bank to make its move. The
+// timeout is never more than maxTimeout when it is set.
+func (mr multiSnakeRequest) timeoutFor(snake *pb.Snake) time.Duration {
+	timeout := mr.timeout
+	if mr.url == "move" && mr.game != nil && mr.game.TimeBank > 0 {
+		timeout = timeBankDuration(snake)
+	}
+	if mr.maxTimeout > 0 && timeout > mr.maxTimeout {
+		timeout = mr.maxTimeout
+	}
+	return timeout
 }
 
 type snakePostOptions struct {
@@ -63,10 +79,6 @@ func gatherAllSnakeResponses(multiReq multiSnakeRequest) []snakeResponse {
 	return gatherSnakeResponses(multiReq, multiReq.frame.Snakes)
 }
 
-func gatherAliveSnakeResponses(multiReq multiSnakeRequest) []snakeResponse {
-	return gatherSnakeResponses(multiReq, multiReq.frame.AliveSnakes())
-}
-
 func gatherSnakeResponses(multiReq multiSnakeRequest, snakes []*pb.Snake) []snakeResponse {
 	respChan := make(chan snakeResponse, len(multiReq.frame.Snakes))
 	wg := sync.WaitGroup{}
@@ -85,7 +97,7 @@ func gatherSnakeResponses(multiReq multiSnakeRequest, snakes []*pb.Snake) []snak
 			options := snakePostOptions{
 				url:     mr.url,
 				snake:   s,
-				timeout: mr.timeout,
+				timeout: mr.timeoutFor(s),
 			}
 			getSnakeResponse(options, mr.game, mr.frame, respChan)
 			wg.Done()
@@ -121,8 +133,9 @@ func postToSnakeServer(req snakePostRequest, resp chan<- snakeResponse) {
 			"id":  req.options.snake.ID,
 		}).Error("error POSTing to snake")
 		resp <- snakeResponse{
-			snake: req.options.snake,
-			err:   err,
+			snake:   req.options.snake,
+			err:     err,
+			latency: latency,
 		}
 		instrument(0, 0)
 		return
@@ -139,10 +152,11 @@ func postToSnakeServer(req snakePostRequest, resp chan<- snakeResponse) {
 	responseData, err := ioutil.ReadAll(io.LimitReader(postResponse.Body, 1000000))
 
 	resp <- snakeResponse{
-		snake:   req.options.snake,
-		data:    responseData,
-		err:     err,
-		latency: latency,
+		snake:      req.options.snake,
+		data:       responseData,
+		err:        err,
+		latency:    latency,
+		statusCode: postResponse.StatusCode,
 	}
 }
 
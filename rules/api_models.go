@@ -26,23 +26,73 @@ type SnakeRequest struct {
 
 // Game represents the current game state
 type Game struct {
-	ID string `json:"id"`
+	ID      string      `json:"id"`
+	Ruleset GameRuleset `json:"ruleset"`
 }
 
-// Board provides information about the game board
+// GameRuleset describes the ruleset the game is played by
+type GameRuleset struct {
+	Name     string          `json:"name"`
+	Settings RulesetSettings `json:"settings"`
+}
+
+// RulesetSettings are the health, length, growth, move and vision settings the
+// game is played with
+type RulesetSettings struct {
+	StartingHealth    int32  `json:"startingHealth"`
+	FoodHealth        int32  `json:"foodHealth"`
+	HealthLossPerTurn int32  `json:"healthLossPerTurn"`
+	StartingLength    int32  `json:"startingLength"`
+	GrowthPerFood     int32  `json:"growthPerFood"`
+	HungryHealth      int32  `json:"hungryHealth"`
+	MinimumLength     int32  `json:"minimumLength"`
+	MoveMode          string `json:"moveMode"`
+	VisionRadius      int32  `json:"visionRadius"`
+	VisionMetric      string `json:"visionMetric"`
+	EndCondition      string `json:"endCondition"`
+	SurvivorCount     int32  `json:"survivorCount"`
+	TargetLength      int32  `json:"targetLength"`
+}
+
+// Board provides information about the game board. On a wrapped board snakes
+// moving off an edge enter again from the opposite edge. Walls are obstacles
+// that kill any snake running into them.
 type Board struct {
-	Height int32    `json:"height"`
-	Width  int32    `json:"width"`
-	Food   []Coords `json:"food"`
-	Snakes []Snake  `json:"snakes"`
+	Height  int32    `json:"height"`
+	Width   int32    `json:"width"`
+	Wrapped bool     `json:"wrapped"`
+	Food    []Food   `json:"food"`
+	Hazards []Coords `json:"hazards"`
+	Walls   []Coords `json:"walls"`
+	Snakes  []Snake  `json:"snakes"`
 }
 
-// Snake represents information about a snake in the game
+// Snake represents information about a snake in the game, TimeBank is the time
+// in ms the snake has left for its moves in games played with a time bank.
+// Death is only set once the snake has died.
 type Snake struct {
-	ID     string   `json:"id"`
-	Name   string   `json:"name"`
-	Health int32    `json:"health"`
-	Body   []Coords `json:"body"`
+	ID       string   `json:"id"`
+	Name     string   `json:"name"`
+	Health   int32    `json:"health"`
+	Body     []Coords `json:"body"`
+	Squad    string   `json:"squad"`
+	TimeBank int32    `json:"timeBank"`
+	Death    *Death   `json:"death,omitempty"`
+}
+
+// Death describes how a snake died, KilledBy is the ID of the snake it
+// collided with when it died colliding with another snake
+type Death struct {
+	Cause    string `json:"cause"`
+	Turn     int32  `json:"turn"`
+	KilledBy string `json:"killedBy,omitempty"`
+}
+
+// Food is a piece of food on the board and the type of food it is
+type Food struct {
+	X    int32  `json:"x"`
+	Y    int32  `json:"y"`
+	Type string `json:"type"`
 }
 
 // Coords represents a point on the board
@@ -59,14 +109,42 @@ func buildSnakeRequest(game *pb.Game, frame *pb.GameFrame, snakeID string) Snake
 			break
 		}
 	}
+	frame = visibleFrame(game, frame, you)
+	ruleset := game.Ruleset
+	if ruleset == "" {
+		ruleset = RulesetStandard
+	}
 	return SnakeRequest{
-		Game: Game{ID: game.ID},
+		Game: Game{
+			ID: game.ID,
+			Ruleset: GameRuleset{
+				Name: ruleset,
+				Settings: RulesetSettings{
+					StartingHealth:    startingHealth(game),
+					FoodHealth:        foodHealth(game),
+					HealthLossPerTurn: healthLossPerTurn(game),
+					StartingLength:    startingLength(game),
+					GrowthPerFood:     growthPerFood(game),
+					HungryHealth:      game.HungryHealth,
+					MinimumLength:     minimumLength(game),
+					MoveMode:          moveMode(game),
+					VisionRadius:      game.VisionRadius,
+					VisionMetric:      visionMetric(game),
+					EndCondition:      endConditionName(game),
+					SurvivorCount:     survivorCount(game),
+					TargetLength:      game.TargetLength,
+				},
+			},
+		},
 		Turn: frame.Turn,
 		Board: Board{
-			Height: game.Height,
-			Width:  game.Width,
-			Food:   convertPoints(frame.Food),
-			Snakes: convertSnakes(frame.AliveSnakes()),
+			Height:  game.Height,
+			Width:   game.Width,
+			Wrapped: game.Wrapped,
+			Food:    convertFood(frame),
+			Hazards: convertPoints(frame.Hazards),
+			Walls:   convertPoints(frame.Walls),
+			Snakes:  convertSnakes(frame.AliveSnakes()),
 		},
 		You: convertSnake(you),
 	}
@@ -82,6 +160,16 @@ func convertPoints(points []*pb.Point) []Coords {
 	return coords
 }
 
+func convertFood(frame *pb.GameFrame) []Food {
+	food := []Food{}
+
+	for _, f := range frame.Food {
+		food = append(food, Food{X: f.X, Y: f.Y, Type: foodType(frame, f)})
+	}
+
+	return food
+}
+
 func convertSnakes(pbSnakes []*pb.Snake) []Snake {
 	snakes := []Snake{}
 
@@ -94,9 +182,23 @@ func convertSnakes(pbSnakes []*pb.Snake) []Snake {
 
 func convertSnake(snake *pb.Snake) Snake {
 	return Snake{
-		ID:     snake.ID,
-		Name:   snake.Name,
-		Health: snake.Health,
-		Body:   convertPoints(snake.Body),
+		ID:       snake.ID,
+		Name:     snake.Name,
+		Health:   snake.Health,
+		Body:     convertPoints(snake.Body),
+		Squad:    snake.Squad,
+		TimeBank: snake.TimeBank,
+		Death:    convertDeath(snake.Death),
+	}
+}
+
+func convertDeath(death *pb.Death) *Death {
+	if death == nil {
+		return nil
+	}
+	return &Death{
+		Cause:    death.Cause,
+		Turn:     death.Turn,
+		KilledBy: death.KilledBy,
 	}
 }
@@ -1,6 +1,7 @@
 package rules
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/battlesnakeio/engine/controller/pb"
@@ -21,10 +22,53 @@ func TestBuildSnakeRequest(t *testing.T) {
 		},
 	}, "snake_123")
 	require.Equal(t, "game_123", req.Game.ID)
+	require.Equal(t, RulesetStandard, req.Game.Ruleset.Name)
+	require.Equal(t, RulesetSettings{
+		StartingHealth:    100,
+		FoodHealth:        100,
+		HealthLossPerTurn: 1,
+		StartingLength:    3,
+		GrowthPerFood:     1,
+		MinimumLength:     1,
+		MoveMode:          MoveModeSimultaneous,
+		VisionMetric:      VisionMetricManhattan,
+		EndCondition:      EndConditionLastStanding,
+		SurvivorCount:     1,
+	}, req.Game.Ruleset.Settings)
 	require.Equal(t, []Coords{{X: 1, Y: 1}}, req.Board.Snakes[0].Body)
 	require.Equal(t, []Coords{{X: 1, Y: 1}}, req.You.Body)
 }
 
+func TestBuildSnakeRequestSquads(t *testing.T) {
+	req := buildSnakeRequest(&pb.Game{
+		ID:      "game_123",
+		Ruleset: RulesetSquad,
+	}, &pb.GameFrame{
+		Snakes: []*pb.Snake{
+			{ID: "snake_123", Squad: "red", Body: []*pb.Point{{X: 1, Y: 1}}},
+			{ID: "snake_456", Squad: "blue", Body: []*pb.Point{{X: 2, Y: 2}}},
+		},
+	}, "snake_123")
+	require.Equal(t, RulesetSquad, req.Game.Ruleset.Name)
+	require.Equal(t, "red", req.You.Squad)
+	require.Equal(t, "red", req.Board.Snakes[0].Squad)
+	require.Equal(t, "blue", req.Board.Snakes[1].Squad)
+}
+
+func TestBuildSnakeRequestFoodTypes(t *testing.T) {
+	req := buildSnakeRequest(&pb.Game{
+		ID: "game_123",
+	}, &pb.GameFrame{
+		Food:      []*pb.Point{{X: 3, Y: 3}, {X: 4, Y: 4}},
+		FoodItems: []*pb.FoodItem{{Point: &pb.Point{X: 4, Y: 4}, Type: FoodTypePoison}},
+		Snakes:    []*pb.Snake{{ID: "snake_123", Body: []*pb.Point{{X: 1, Y: 1}}}},
+	}, "snake_123")
+	require.Equal(t, []Food{
+		{X: 3, Y: 3, Type: FoodTypeNormal},
+		{X: 4, Y: 4, Type: FoodTypePoison},
+	}, req.Board.Food)
+}
+
 func TestBuildSnakeRequestWithOnlyAliveSnakes(t *testing.T) {
 	req := buildSnakeRequest(&pb.Game{
 		ID: "game_123",
@@ -55,3 +99,69 @@ func TestBuildSnakeRequestWithOnlyAliveSnakes(t *testing.T) {
 	require.Equal(t, []Coords{{X: 1, Y: 1}}, req.Board.Snakes[0].Body)
 	require.Equal(t, []Coords{{X: 1, Y: 1}}, req.You.Body)
 }
+
+func TestBuildSnakeRequestWrappedBoard(t *testing.T) {
+	req := buildSnakeRequest(&pb.Game{
+		ID:      "game_123",
+		Width:   11,
+		Height:  11,
+		Wrapped: true,
+	}, &pb.GameFrame{
+		Snakes: []*pb.Snake{
+			{ID: "snake_123", Body: []*pb.Point{{X: 1, Y: 1}}},
+		},
+	}, "snake_123")
+	require.True(t, req.Board.Wrapped)
+	require.Equal(t, int32(11), req.Board.Width)
+	require.Equal(t, int32(11), req.Board.Height)
+}
+
+func TestBuildSnakeRequestWalls(t *testing.T) {
+	req := buildSnakeRequest(&pb.Game{
+		ID: "game_123",
+	}, &pb.GameFrame{
+		Snakes: []*pb.Snake{
+			{ID: "snake_123", Body: []*pb.Point{{X: 1, Y: 1}}},
+		},
+		Walls: []*pb.Point{{X: 3, Y: 3}},
+	}, "snake_123")
+	require.Equal(t, []Coords{{X: 3, Y: 3}}, req.Board.Walls)
+}
+
+func TestBuildSnakeRequestHazards(t *testing.T) {
+	req := buildSnakeRequest(&pb.Game{
+		ID: "game_123",
+	}, &pb.GameFrame{
+		Snakes: []*pb.Snake{
+			{ID: "snake_123", Body: []*pb.Point{{X: 1, Y: 1}}},
+		},
+		Hazards: []*pb.Point{{X: 0, Y: 0}},
+	}, "snake_123")
+	require.Equal(t, []Coords{{X: 0, Y: 0}}, req.Board.Hazards)
+}
+
+func TestBuildSnakeRequestDeath(t *testing.T) {
+	frame := &pb.GameFrame{
+		Snakes: []*pb.Snake{
+			{ID: "snake_123", Body: []*pb.Point{{X: 1, Y: 1}}, Death: &pb.Death{
+				Cause:    DeathCauseHeadToHeadCollision,
+				Turn:     12,
+				KilledBy: "snake_456",
+			}},
+			{ID: "snake_456", Body: []*pb.Point{{X: 1, Y: 1}, {X: 1, Y: 2}}},
+		},
+	}
+	req := buildSnakeRequest(&pb.Game{ID: "game_123"}, frame, "snake_123")
+	require.Equal(t, &Death{Cause: DeathCauseHeadToHeadCollision, Turn: 12, KilledBy: "snake_456"}, req.You.Death)
+
+	data, err := json.Marshal(req.You)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"death":{"cause":"head-collision","turn":12,"killedBy":"snake_456"}`)
+
+	// Snakes that are still alive have no death.
+	req = buildSnakeRequest(&pb.Game{ID: "game_123"}, frame, "snake_456")
+	require.Nil(t, req.You.Death)
+	data, err = json.Marshal(req.You)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "death")
+}
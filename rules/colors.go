@@ -1,6 +1,10 @@
 package rules
 
-import "sync"
+import (
+	"math/rand"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+)
 
 var defaultColors = []string{
 	"#8f4949",
@@ -24,19 +28,14 @@ var defaultColors = []string{
 
 var palette = defaultColors
 
-var colorIndex = 0
-
-var colorMutex = &sync.Mutex{}
-
-func nextColorIndex() int {
-	colorMutex.Lock()
-	defer colorMutex.Unlock()
-
-	current := colorIndex
-	colorIndex = (colorIndex + 1) % len(palette)
-	return current
-}
-
-func nextColor() string {
-	return palette[nextColorIndex()]
+// pickColors assigns each snake a color from the palette. Colors are handed out
+// in order starting from a random point in the palette, so snakes in the same
+// game get different colors.
+func pickColors(snakes []*pb.Snake, rng *rand.Rand) map[string]string {
+	colors := map[string]string{}
+	offset := rng.Intn(len(palette))
+	for i, s := range snakes {
+		colors[s.ID] = palette[(offset+i)%len(palette)]
+	}
+	return colors
 }
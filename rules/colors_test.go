@@ -3,25 +3,23 @@ package rules
 import (
 	"testing"
 
+	"github.com/battlesnakeio/engine/controller/pb"
 	"github.com/stretchr/testify/require"
 )
 
-func TestNextColor(t *testing.T) {
-	resetPalette([]string{"red", "green", "blue"})
+func TestPickColors(t *testing.T) {
+	resetPalette(defaultColors)
+	snakes := []*pb.Snake{{ID: "1"}, {ID: "2"}, {ID: "3"}}
 
-	// test each color
-	require.Equal(t, "red", nextColor())
-	require.Equal(t, "green", nextColor())
-	require.Equal(t, "blue", nextColor())
+	colors := pickColors(snakes, newTurnRand(42, 0))
+	require.Len(t, colors, 3)
+	require.NotEqual(t, colors["1"], colors["2"])
+	require.NotEqual(t, colors["2"], colors["3"])
 
-	// test wrap around
-	require.Equal(t, "red", nextColor())
+	// the same seed always picks the same colors
+	require.Equal(t, colors, pickColors(snakes, newTurnRand(42, 0)))
 }
 
 func resetPalette(colors []string) {
-	colorMutex.Lock()
-	defer colorMutex.Unlock()
-
 	palette = colors
-	colorIndex = 0
 }
@@ -0,0 +1,33 @@
+package rules
+
+import "github.com/battlesnakeio/engine/controller/pb"
+
+// RulesetConstrictor is the name of the constrictor ruleset
+const RulesetConstrictor = "constrictor"
+
+func init() {
+	RegisterRuleset(RulesetConstrictor, ConstrictorRuleset{})
+}
+
+var constrictorOptions = ruleOptions{
+	growEveryTurn: true,
+	noFood:        true,
+}
+
+// ConstrictorRuleset plays the standard game without any food. Every snake grows
+// every turn and stays at full health, so the game comes down to controlling
+// space on the board.
+type ConstrictorRuleset struct {
+	StandardRuleset
+}
+
+// CreateInitialGame creates a new constrictor game, no food is placed on the
+// board.
+func (ConstrictorRuleset) CreateInitialGame(req *pb.CreateRequest) (*pb.Game, []*pb.GameFrame, error) {
+	return createInitialGame(req, constrictorOptions)
+}
+
+// GameTick runs the game one tick, growing every snake instead of spawning food.
+func (ConstrictorRuleset) GameTick(game *pb.Game, lastFrame *pb.GameFrame) (*pb.GameFrame, error) {
+	return gameTick(game, lastFrame, constrictorOptions)
+}
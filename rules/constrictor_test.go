@@ -0,0 +1,42 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstrictorCreateInitialGameNoFood(t *testing.T) {
+	g, frames, err := CreateInitialGame(&pb.CreateRequest{
+		Width:   11,
+		Height:  11,
+		Food:    5,
+		Ruleset: RulesetConstrictor,
+		Snakes:  []*pb.SnakeOptions{{Name: "one"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, RulesetConstrictor, g.Ruleset)
+	require.Equal(t, FoodSpawnerNone, g.FoodSpawner)
+	require.Len(t, frames[0].Food, 0)
+	require.Len(t, frames[0].Snakes, 1)
+}
+
+func TestConstrictorGameTickGrowsSnakes(t *testing.T) {
+	game := &pb.Game{Width: 10, Height: 10, Ruleset: RulesetConstrictor, FoodSpawner: FoodSpawnerNone}
+	snake := &pb.Snake{
+		ID:     "one",
+		Health: 50,
+		Body:   []*pb.Point{{X: 2, Y: 2}, {X: 2, Y: 3}, {X: 2, Y: 4}, {X: 2, Y: 4}},
+	}
+	next, err := GameTick(game, &pb.GameFrame{
+		Turn:   1,
+		Snakes: []*pb.Snake{snake},
+	})
+	require.NoError(t, err)
+	require.Nil(t, snake.Death)
+	require.Equal(t, int32(100), snake.Health)
+	require.Len(t, snake.Body, 5)
+	require.Equal(t, &pb.Point{X: 2, Y: 4}, snake.Tail())
+	require.Len(t, next.Food, 0)
+}
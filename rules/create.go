@@ -2,6 +2,7 @@ package rules
 
 import (
 	"errors"
+	"fmt"
 	"math/rand"
 
 	"github.com/battlesnakeio/engine/controller/pb"
@@ -18,135 +19,282 @@ const (
 	// GameModeMultiPlayer represents when there is more then 1 snake in the game, this means the game will
 	// run until there is zero or one snakes left alive in the game.
 	GameModeMultiPlayer GameMode = "multi-player"
+	// GameModeSurvival represents a game that runs until every snake has died, the snakes are ranked
+	// by how long they survived.
+	GameModeSurvival GameMode = "survival"
 )
 
+// maxSnakeTimeout is the longest a snake can be given to respond, in ms.
+const maxSnakeTimeout = 5000
+
 func getSnakeTimeout(req *pb.CreateRequest) int32 {
 	snakeTimeout := req.SnakeTimeout
-	if snakeTimeout < 1 || snakeTimeout > 5000 {
+	if snakeTimeout < 1 || snakeTimeout > maxSnakeTimeout {
 		snakeTimeout = 500
 	}
 	return snakeTimeout
 }
 
-// CreateInitialGame creates a new game based on the create request passed in
+// CreateInitialGame creates a new game based on the create request passed in,
+// using the ruleset named in the request. Snakes are notified of the game
+// start once the ruleset has set up the game.
 func CreateInitialGame(req *pb.CreateRequest) (*pb.Game, []*pb.GameFrame, error) {
-	snakes, err := getSnakes(req)
+	name := req.Ruleset
+	if name == "" {
+		name = RulesetStandard
+	}
+	ruleset, err := GetRuleset(name)
 	if err != nil {
 		return nil, nil, err
 	}
-	food, err := generateFood(req, snakes)
+
+	game, frames, err := ruleset.CreateInitialGame(req)
 	if err != nil {
 		return nil, nil, err
 	}
-	snakeTimeout := getSnakeTimeout(req)
+	game.Ruleset = name
 
-	id := uuid.NewV4().String()
-	game := &pb.Game{
-		ID:                      id,
-		Width:                   req.Width,
-		Height:                  req.Height,
-		Status:                  string(GameStatusStopped),
-		SnakeTimeout:            snakeTimeout,
-		Mode:                    string(GameModeMultiPlayer),
-		MaxTurnsToNextFoodSpawn: req.MaxTurnsToNextFoodSpawn,
+	notifyGameStart(game, frames[0])
+
+	return game, frames, nil
+}
+
+// CreateInitialGame creates a new standard game based on the create request passed in
+func (StandardRuleset) CreateInitialGame(req *pb.CreateRequest) (*pb.Game, []*pb.GameFrame, error) {
+	return createInitialGame(req, ruleOptions{})
+}
+
+// createInitialGame creates a new game with the given rule options applied
+func createInitialGame(req *pb.CreateRequest, opts ruleOptions) (*pb.Game, []*pb.GameFrame, error) {
+	game, rng, err := newGame(req)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	if len(snakes) == 1 {
-		game.Mode = string(GameModeSinglePlayer)
+	board, err := getRequestMap(req, rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	walls := []*pb.Point{}
+	if board != nil {
+		game.Width = board.Width
+		game.Height = board.Height
+		game.Map = board.Name
+		game.FoodSpawns = board.foodSpawns()
+		walls = board.walls()
+	}
+	game.FoodSpawner = gameFoodSpawner(game)
+	if opts.noFood {
+		game.FoodSpawner = FoodSpawnerNone
+	}
+	if err := validateFoodSpawner(game); err != nil {
+		return nil, nil, err
+	}
+
+	if err := validateStartingLength(req, game); err != nil {
+		return nil, nil, err
+	}
+
+	snakes, err := getSnakes(req, game, board, rng)
+	if err != nil {
+		return nil, nil, err
+	}
+	food := []*pb.Point{}
+	foodItems := []*pb.FoodItem{}
+	if !opts.noFood {
+		food, err = generateFood(req, game, walls, snakes, rng)
+		if err != nil {
+			return nil, nil, err
+		}
+		foodItems = typeFood(game, food, rng)
 	}
 
 	frames := []*pb.GameFrame{
 		{
-			Turn:   0,
-			Food:   food,
-			Snakes: snakes,
+			Turn:      0,
+			Food:      food,
+			Snakes:    snakes,
+			Walls:     walls,
+			FoodItems: foodItems,
 		},
 	}
 
-	notifyGameStart(game, frames[0])
-
 	return game, frames, nil
 }
 
-func isTournamentBoardSize(req *pb.CreateRequest) bool {
-	return isSmallBoard(req) || isMediumBoard(req) || isLargeBoard(req)
-}
-
-func isSmallBoard(req *pb.CreateRequest) bool {
-	return req.Width == 7 && req.Height == 7
-}
+// newGame validates the settings in a create request and creates the game
+// with them, it returns the random source for setting up the game's board.
+func newGame(req *pb.CreateRequest) (*pb.Game, *rand.Rand, error) {
+	if err := validateSettings(req); err != nil {
+		return nil, nil, err
+	}
 
-func isMediumBoard(req *pb.CreateRequest) bool {
-	return req.Width == 11 && req.Height == 11
-}
+	seed := req.Seed
+	if seed == 0 {
+		seed = newGameSeed()
+	}
+	rng := newTurnRand(seed, 0)
+	snakeTimeout := getSnakeTimeout(req)
 
-func isLargeBoard(req *pb.CreateRequest) bool {
-	return req.Width == 19 && req.Height == 19
+	id := uuid.NewV4().String()
+	game := &pb.Game{
+		ID:                      id,
+		Width:                   req.Width,
+		Height:                  req.Height,
+		Status:                  string(GameStatusStopped),
+		SnakeTimeout:            snakeTimeout,
+		Mode:                    string(requestGameMode(req)),
+		MaxTurnsToNextFoodSpawn: req.MaxTurnsToNextFoodSpawn,
+		Seed:                    seed,
+		Wrapped:                 req.Wrapped,
+		StartingHealth:          settingOrDefault(req.StartingHealth, defaultStartingHealth),
+		FoodHealth:              settingOrDefault(req.FoodHealth, defaultFoodHealth),
+		HealthLossPerTurn:       settingOrDefault(req.HealthLossPerTurn, defaultHealthLossPerTurn),
+		StartingLength:          settingOrDefault(req.StartingLength, defaultStartingLength),
+		GrowthPerFood:           settingOrDefault(req.GrowthPerFood, defaultGrowthPerFood),
+		HungryHealth:            req.HungryHealth,
+		MinimumLength:           settingOrDefault(req.MinimumLength, defaultMinimumLength),
+		MaxTurns:                req.MaxTurns,
+		Tiebreaker:              req.Tiebreaker,
+		FoodSpawner:             req.FoodSpawner,
+		MinimumFood:             settingOrDefault(req.MinimumFood, req.Food),
+		FoodSpawnInterval:       req.FoodSpawnInterval,
+		FoodPlacement:           req.FoodPlacement,
+		FoodMinHeadDistance:     settingOrDefault(req.FoodMinHeadDistance, defaultFoodMinHeadDistance),
+		FoodWeights:             req.FoodWeights,
+		MoveMode:                req.MoveMode,
+		VisionRadius:            req.VisionRadius,
+		VisionMetric:            req.VisionMetric,
+		TimeBank:                req.TimeBank,
+		TimeIncrement:           req.TimeIncrement,
+		FailurePolicy:           req.FailurePolicy,
+		MaxFailures:             settingOrDefault(req.MaxFailures, defaultMaxFailures),
+		EndCondition:            req.EndCondition,
+		SurvivorCount:           settingOrDefault(req.SurvivorCount, defaultSurvivorCount),
+		TargetLength:            req.TargetLength,
+	}
+	if game.Tiebreaker == "" {
+		game.Tiebreaker = TiebreakerDraw
+	}
+	if game.FoodPlacement == "" {
+		game.FoodPlacement = FoodPlacementRandom
+	}
+	if game.MoveMode == "" {
+		game.MoveMode = MoveModeSimultaneous
+	}
+	if game.VisionMetric == "" {
+		game.VisionMetric = VisionMetricManhattan
+	}
+	if game.FailurePolicy == "" {
+		game.FailurePolicy = FailurePolicyDefaultMove
+	}
+	if game.EndCondition == "" {
+		game.EndCondition = modeEndCondition(GameMode(game.Mode))
+	}
+	return game, rng, nil
 }
 
-func getTournamentStartPoint(size, index int32, snakes []*pb.Snake) *pb.Point {
-	if size == 7 {
-		return smallStarts[index]
-	} else if size == 11 {
-		return mediumStarts[index]
-	} else if size == 19 {
-		return largeStarts[index]
+// getRequestMap returns the map named in the create request, or a new map from
+// the requested map generator. It returns nil when the game isn't played on a
+// map. The board size in the request must match a named map if it is set.
+func getRequestMap(req *pb.CreateRequest, rng *rand.Rand) (*Map, error) {
+	if req.MapGenerator != "" {
+		if req.Map != "" {
+			return nil, errors.New("a game can't use both a map and a map generator")
+		}
+		return generateMap(req.MapGenerator, req.Width, req.Height, len(req.Snakes), rng)
 	}
-
-	return getUnoccupiedPoint(size, size, []*pb.Point{}, snakes)
+	if req.Map == "" {
+		return nil, nil
+	}
+	board, err := GetMap(req.Map)
+	if err != nil {
+		return nil, err
+	}
+	if (req.Width != 0 && req.Width != board.Width) || (req.Height != 0 && req.Height != board.Height) {
+		return nil, fmt.Errorf("board size %dx%d does not match map %q", req.Width, req.Height, board.Name)
+	}
+	return board, nil
 }
 
-func getSnakes(req *pb.CreateRequest) ([]*pb.Snake, error) {
+func getSnakes(req *pb.CreateRequest, game *pb.Game, board *Map, rng *rand.Rand) ([]*pb.Snake, error) {
 	var snakes []*pb.Snake
-	even := rand.Float32() < 0.5
+	walls := []*pb.Point{}
+	starts := []*pb.Point{}
+	if board != nil {
+		walls = board.walls()
+		starts = board.starts()
+		if len(starts) > 0 && len(req.Snakes) > len(starts) {
+			return nil, fmt.Errorf("map %q only has %d start positions", board.Name, len(starts))
+		}
+	}
+	even := rng.Float32() < 0.5
+	if board == nil {
+		starts = tournamentStarts(game.Width, game.Height, len(req.Snakes), rng)
+	}
 	for index, opts := range req.Snakes {
 		var startPoint *pb.Point
-		if isTournamentBoardSize(req) {
-			startPoint = getTournamentStartPoint(req.Width, int32(index), snakes)
+		if len(starts) > 0 {
+			startPoint = starts[index]
 		} else {
 			if even {
-				startPoint = getUnoccupiedPointEven(req.Width, req.Height, []*pb.Point{}, snakes)
+				startPoint = getUnoccupiedPointEven(game.Width, game.Height, walls, snakes, rng)
 			} else {
-				startPoint = getUnoccupiedPointOdd(req.Width, req.Height, []*pb.Point{}, snakes)
+				startPoint = getUnoccupiedPointOdd(game.Width, game.Height, walls, snakes, rng)
 			}
 		}
 		if startPoint == nil {
 			return nil, errors.New("no unoccupied spots left for new snake")
 		}
-		snake := &pb.Snake{
-			ID:       opts.ID,
-			Name:     opts.Name,
-			URL:      opts.URL,
-			Health:   100,
-			HeadType: opts.HeadType,
-			TailType: opts.TailType,
-			Body: []*pb.Point{
-				startPoint,
-				startPoint.Clone(),
-				startPoint.Clone(),
-			},
+		body := []*pb.Point{startPoint}
+		for i := int32(1); i < startingLength(game); i++ {
+			body = append(body, startPoint.Clone())
 		}
-		if len(snake.ID) == 0 {
-			snake.ID = uuid.NewV4().String()
+		var err error
+		snakes, err = appendSnake(snakes, newSnake(game, opts, body, rng))
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		for _, s := range snakes {
-			if s.ID == snake.ID {
-				return nil, errors.New("duplicate snake id found, create aborted")
-			}
-		}
+	return snakes, nil
+}
 
-		snakes = append(snakes, snake)
+// newSnake creates a snake from its options with the given body, snakes
+// without an ID are given a random one.
+func newSnake(game *pb.Game, opts *pb.SnakeOptions, body []*pb.Point, rng *rand.Rand) *pb.Snake {
+	snake := &pb.Snake{
+		ID:       opts.ID,
+		Name:     opts.Name,
+		URL:      opts.URL,
+		Health:   startingHealth(game),
+		HeadType: opts.HeadType,
+		TailType: opts.TailType,
+		Squad:    opts.Squad,
+		TimeBank: game.TimeBank,
+		Body:     body,
 	}
+	if len(snake.ID) == 0 {
+		snake.ID = newSnakeID(rng)
+	}
+	return snake
+}
 
-	return snakes, nil
+// appendSnake adds a snake to the game's snakes, snake IDs must be unique.
+func appendSnake(snakes []*pb.Snake, snake *pb.Snake) ([]*pb.Snake, error) {
+	for _, s := range snakes {
+		if s.ID == snake.ID {
+			return nil, errors.New("duplicate snake id found, create aborted")
+		}
+	}
+	return append(snakes, snake), nil
 }
 
-func generateFood(req *pb.CreateRequest, snakes []*pb.Snake) ([]*pb.Point, error) {
+func generateFood(req *pb.CreateRequest, game *pb.Game, walls []*pb.Point, snakes []*pb.Snake, rng *rand.Rand) ([]*pb.Point, error) {
 	food := []*pb.Point{}
 
 	for i := int32(0); i < req.Food; i++ {
-		p := getUnoccupiedPoint(req.Width, req.Height, food, snakes)
+		p := getFoodSpawnPoint(game, walls, food, snakes, rng)
 		if p != nil {
 			food = append(food, p)
 		}
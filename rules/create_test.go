@@ -1,7 +1,6 @@
 package rules
 
 import (
-	"math/rand"
 	"testing"
 
 	"github.com/battlesnakeio/engine/controller/pb"
@@ -55,9 +54,7 @@ func TestCreateInitialGame_MoreSnakesThanSpace(t *testing.T) {
 	require.Error(t, err)
 }
 
-// Do not run this test in parallel as it sets the random seed.
 func TestCreateInitialGameWithColour(t *testing.T) {
-	rand.Seed(6) // this line, and value 6 for Seed, ensures that random snake placements will make this test fail.
 	url := setupSnakeServer(t, MoveResponse{}, StartResponse{
 		Color: "#CDCDCD",
 	})
@@ -65,6 +62,7 @@ func TestCreateInitialGameWithColour(t *testing.T) {
 		Width:  10,
 		Height: 10,
 		Food:   10,
+		Seed:   6,
 		Snakes: []*pb.SnakeOptions{
 			{URL: url},
 			{URL: url},
@@ -99,10 +97,10 @@ func TestTournamentCreateGame(t *testing.T) {
 	require.Len(t, frame, 1)
 	require.Len(t, frame[0].Snakes, 2)
 	require.Len(t, frame[0].Snakes[0].Body, 3)
-	require.Equal(t, int32(1), frame[0].Snakes[0].Body[0].X)
-	require.Equal(t, int32(1), frame[0].Snakes[0].Body[0].Y)
-	require.Equal(t, int32(5), frame[0].Snakes[1].Body[0].X)
-	require.Equal(t, int32(5), frame[0].Snakes[1].Body[0].Y)
+	// The snakes start in opposite corners, whichever way round.
+	heads := []*pb.Point{frame[0].Snakes[0].Head(), frame[0].Snakes[1].Head()}
+	require.True(t, containsPoint(heads, &pb.Point{X: 1, Y: 1}))
+	require.True(t, containsPoint(heads, &pb.Point{X: 5, Y: 5}))
 }
 
 func TestIsValidColour(t *testing.T) {
@@ -125,8 +123,9 @@ func TestGetEffectiveColour(t *testing.T) {
 		Color: "#CDCDCD",
 		Snake: &pb.Snake{},
 	}
-	colour := getEffectiveColor(meta)
+	colour := getEffectiveColor(meta, "#8f4949")
 	require.Equal(t, "#CDCDCD", colour)
 	meta.Color = "#aaaaaaa"
-	require.NotEqual(t, "#aaaaaaa", colour)
+	colour = getEffectiveColor(meta, "#8f4949")
+	require.Equal(t, "#8f4949", colour)
 }
@@ -8,17 +8,43 @@ type deathUpdate struct {
 }
 
 // checkForDeath looks through the snakes with the updated coords and checks to see if any have died
-// possible death options are starvation (health has reached 0), wall collision, snake body collision
-// snake head collision (other snake is same size or greater)
-func checkForDeath(width, height int32, frame *pb.GameFrame) []deathUpdate {
+// possible death options are running out of time, failing too many move requests, starvation (health
+// has reached 0), wall collision, obstacle collision, snake body collision, snake head collision (other
+// snake is same size or greater). Deaths from colliding with another snake record
+// the ID of that snake as the killer.
+func checkForDeath(game *pb.Game, frame *pb.GameFrame, opts ruleOptions) []deathUpdate {
 	updates := []deathUpdate{}
 	for _, s := range frame.AliveSnakes() {
+		if deathByTimeBank(game, s) {
+			updates = append(updates, deathUpdate{
+				Snake: s,
+				Death: &pb.Death{
+					Turn:  frame.Turn,
+					Cause: DeathCauseTimeBankExpired,
+				},
+			})
+			continue
+		}
+		if deathByFailures(game, s) {
+			updates = append(updates, deathUpdate{
+				Snake: s,
+				Death: &pb.Death{
+					Turn:  frame.Turn,
+					Cause: s.LastFailure,
+				},
+			})
+			continue
+		}
 		if deathByHealth(s.Health) {
+			cause := DeathCauseStarvation
+			if deathByHazard(s, game, frame) {
+				cause = DeathCauseHazard
+			}
 			updates = append(updates, deathUpdate{
 				Snake: s,
 				Death: &pb.Death{
 					Turn:  frame.Turn,
-					Cause: DeathCauseStarvation,
+					Cause: cause,
 				},
 			})
 			continue
@@ -27,7 +53,7 @@ func checkForDeath(width, height int32, frame *pb.GameFrame) []deathUpdate {
 		if head == nil {
 			continue
 		}
-		if deathByOutOfBounds(head, width, height) {
+		if deathByOutOfBounds(head, game) {
 			updates = append(updates, deathUpdate{
 				Snake: s,
 				Death: &pb.Death{
@@ -37,37 +63,56 @@ func checkForDeath(width, height int32, frame *pb.GameFrame) []deathUpdate {
 			})
 			continue
 		}
+		if containsPoint(frame.Walls, head) {
+			updates = append(updates, deathUpdate{
+				Snake: s,
+				Death: &pb.Death{
+					Turn:  frame.Turn,
+					Cause: DeathCauseObstacleCollision,
+				},
+			})
+			continue
+		}
 
 		for _, other := range frame.AliveSnakes() {
-			if deathByHeadCollision(s, other) {
+			if deathByHeadCollision(s, other, frame) {
 				updates = append(updates, deathUpdate{
 					Snake: s,
 					Death: &pb.Death{
-						Turn:  frame.Turn,
-						Cause: DeathCauseHeadToHeadCollision,
+						Turn:     frame.Turn,
+						Cause:    DeathCauseHeadToHeadCollision,
+						KilledBy: other.ID,
 					},
 				})
 			}
 
+			if opts.allowSquadBodyCollisions && s.ID != other.ID && sameSquad(s, other) {
+				continue
+			}
 			for i, b := range other.Body {
 				if i == 0 {
 					continue
 				}
+				// segments grown onto a one segment snake are stacked on its
+				// head, they aren't a collision for it or any other snake
+				if i < stackedOnHead(other) {
+					continue
+				}
 
 				if deathByBodyCollision(s.Head(), b) {
-					var cause string
+					death := &pb.Death{
+						Turn:     frame.Turn,
+						Cause:    DeathCauseSnakeCollision,
+						KilledBy: other.ID,
+					}
 					if s.ID == other.ID {
-						cause = DeathCauseSnakeSelfCollision
-					} else {
-						cause = DeathCauseSnakeCollision
+						death.Cause = DeathCauseSnakeSelfCollision
+						death.KilledBy = ""
 					}
 
 					updates = append(updates, deathUpdate{
 						Snake: s,
-						Death: &pb.Death{
-							Turn:  frame.Turn,
-							Cause: cause,
-						},
+						Death: death,
 					})
 					break
 				}
@@ -77,18 +122,52 @@ func checkForDeath(width, height int32, frame *pb.GameFrame) []deathUpdate {
 	return updates
 }
 
+// stackedOnHead returns how many body segments from the start of the snake's
+// body are on the same point as its head, including the head.
+func stackedOnHead(s *pb.Snake) int {
+	head := s.Head()
+	n := 0
+	for _, b := range s.Body {
+		if !b.Equal(head) {
+			break
+		}
+		n++
+	}
+	return n
+}
+
 func deathByHealth(health int32) bool {
 	return health <= 0
 }
 
+// deathByHazard checks if a starving snake would have survived the turn
+// without the extra damage from the hazard its head is in.
+func deathByHazard(s *pb.Snake, game *pb.Game, frame *pb.GameFrame) bool {
+	return game.HazardDamage > 0 && isHazard(s.Head(), frame.Hazards) && s.Health+game.HazardDamage > 0
+}
+
 func deathByBodyCollision(head, body *pb.Point) bool {
 	return head.Equal(body)
 }
 
-func deathByOutOfBounds(head *pb.Point, width, height int32) bool {
-	return (head.X < 0) || (head.X >= width) || (head.Y < 0) || (head.Y >= height)
+// deathByOutOfBounds checks if the head has left the board, snakes can never
+// leave a wrapped board since they re-enter on the opposite edge.
+func deathByOutOfBounds(head *pb.Point, game *pb.Game) bool {
+	if game.Wrapped {
+		return false
+	}
+	return (head.X < 0) || (head.X >= game.Width) || (head.Y < 0) || (head.Y >= game.Height)
 }
 
-func deathByHeadCollision(snake, other *pb.Snake) bool {
-	return (other.ID != snake.ID) && (snake.Head().Equal(other.Head())) && (len(snake.Body) <= len(other.Body))
+// deathByHeadCollision checks if the snake loses a head to head collision with
+// the other snake. When snakes move one at a time the snake that moved onto the
+// other snake's head dies, whatever their lengths.
+func deathByHeadCollision(snake, other *pb.Snake, frame *pb.GameFrame) bool {
+	if other.ID == snake.ID || !snake.Head().Equal(other.Head()) {
+		return false
+	}
+	if len(frame.MoveOrder) > 0 {
+		return movedBefore(frame, other, snake)
+	}
+	return len(snake.Body) <= len(other.Body)
 }
@@ -11,4 +11,20 @@ const (
 	DeathCauseHeadToHeadCollision = "head-collision"
 	// DeathCauseWallCollision is when a snake runs off the board
 	DeathCauseWallCollision = "wall-collision"
+	// DeathCauseObstacleCollision is when a snake runs into a wall on a map
+	DeathCauseObstacleCollision = "obstacle-collision"
+	// DeathCauseHazard is when a snake starves because of the extra damage from a hazard
+	DeathCauseHazard = "hazard"
+	// DeathCauseSquadEliminated is when a snake dies because a squad-mate died
+	DeathCauseSquadEliminated = "squad-eliminated"
+	// DeathCauseTimeBankExpired is when a snake runs out of time in its time bank
+	DeathCauseTimeBankExpired = "time-bank-expired"
+	// DeathCauseTimeout is when a snake is eliminated for failing move requests and the last one timed out
+	DeathCauseTimeout = "timeout"
+	// DeathCauseInvalidResponse is when a snake is eliminated for failing move requests and the last one
+	// wasn't a valid move
+	DeathCauseInvalidResponse = "invalid-response"
+	// DeathCauseRequestFailed is when a snake is eliminated for failing move requests and the last one
+	// couldn't be made
+	DeathCauseRequestFailed = "request-failed"
 )
@@ -8,14 +8,14 @@ import (
 )
 
 func TestDeathCauseStarvation(t *testing.T) {
-	updates := checkForDeath(20, 20, &pb.GameFrame{
+	updates := checkForDeath(&pb.Game{Width: 20, Height: 20}, &pb.GameFrame{
 		Turn: 3,
 		Snakes: []*pb.Snake{
 			&pb.Snake{
 				Health: 0,
 			},
 		},
-	})
+	}, ruleOptions{})
 	require.Len(t, updates, 1)
 	require.Equal(t, DeathCauseStarvation, updates[0].Death.Cause)
 	require.Equal(t, int32(3), updates[0].Death.Turn)
@@ -30,7 +30,7 @@ func TestDeathCauseWallCollision(t *testing.T) {
 		{X: 1, Y: 20},
 	}
 	for _, p := range points {
-		updates := checkForDeath(20, 20, &pb.GameFrame{
+		updates := checkForDeath(&pb.Game{Width: 20, Height: 20}, &pb.GameFrame{
 			Turn: 3,
 			Snakes: []*pb.Snake{
 				&pb.Snake{
@@ -38,15 +38,28 @@ func TestDeathCauseWallCollision(t *testing.T) {
 					Body:   []*pb.Point{p},
 				},
 			},
-		})
+		}, ruleOptions{})
 		require.Len(t, updates, 1)
 		require.Equal(t, DeathCauseWallCollision, updates[0].Death.Cause)
 		require.Equal(t, int32(3), updates[0].Death.Turn)
 	}
 }
 
+func TestDeathNoWallCollisionOnWrappedBoard(t *testing.T) {
+	updates := checkForDeath(&pb.Game{Width: 20, Height: 20, Wrapped: true}, &pb.GameFrame{
+		Turn: 3,
+		Snakes: []*pb.Snake{
+			{
+				Health: 45,
+				Body:   []*pb.Point{{X: -1, Y: 1}},
+			},
+		},
+	}, ruleOptions{})
+	require.Len(t, updates, 0)
+}
+
 func TestDeathCauseSnakeCollision(t *testing.T) {
-	updates := checkForDeath(20, 20, &pb.GameFrame{
+	updates := checkForDeath(&pb.Game{Width: 20, Height: 20}, &pb.GameFrame{
 		Turn: 3,
 		Snakes: []*pb.Snake{
 			&pb.Snake{
@@ -65,14 +78,15 @@ func TestDeathCauseSnakeCollision(t *testing.T) {
 				},
 			},
 		},
-	})
+	}, ruleOptions{})
 	require.Len(t, updates, 1)
 	require.Equal(t, DeathCauseSnakeCollision, updates[0].Death.Cause)
 	require.Equal(t, int32(3), updates[0].Death.Turn)
+	require.Equal(t, "2", updates[0].Death.KilledBy)
 }
 
 func TestDeathCauseHeadToHeadCollision(t *testing.T) {
-	updates := checkForDeath(20, 20, &pb.GameFrame{
+	updates := checkForDeath(&pb.Game{Width: 20, Height: 20}, &pb.GameFrame{
 		Turn: 3,
 		Snakes: []*pb.Snake{
 			&pb.Snake{
@@ -92,16 +106,18 @@ func TestDeathCauseHeadToHeadCollision(t *testing.T) {
 				},
 			},
 		},
-	})
+	}, ruleOptions{})
 	require.Len(t, updates, 2)
 	require.Equal(t, DeathCauseHeadToHeadCollision, updates[0].Death.Cause)
 	require.Equal(t, int32(3), updates[0].Death.Turn)
 	require.Equal(t, DeathCauseHeadToHeadCollision, updates[1].Death.Cause)
 	require.Equal(t, int32(3), updates[1].Death.Turn)
+	require.Equal(t, "2", updates[0].Death.KilledBy)
+	require.Equal(t, "1", updates[1].Death.KilledBy)
 }
 
 func TestDeathCauseHeadToHeadCollisionDifferentLengths(t *testing.T) {
-	updates := checkForDeath(20, 20, &pb.GameFrame{
+	updates := checkForDeath(&pb.Game{Width: 20, Height: 20}, &pb.GameFrame{
 		Turn: 3,
 		Snakes: []*pb.Snake{
 			{
@@ -122,15 +138,16 @@ func TestDeathCauseHeadToHeadCollisionDifferentLengths(t *testing.T) {
 				},
 			},
 		},
-	})
+	}, ruleOptions{})
 	require.Len(t, updates, 1)
 	require.Equal(t, DeathCauseHeadToHeadCollision, updates[0].Death.Cause)
 	require.Equal(t, int32(3), updates[0].Death.Turn)
 	require.Equal(t, "2", updates[0].Snake.ID)
+	require.Equal(t, "1", updates[0].Death.KilledBy)
 }
 
 func TestDeathCauseSnakeSelfCollision(t *testing.T) {
-	updates := checkForDeath(20, 20, &pb.GameFrame{
+	updates := checkForDeath(&pb.Game{Width: 20, Height: 20}, &pb.GameFrame{
 		Turn: 3,
 		Snakes: []*pb.Snake{
 			&pb.Snake{
@@ -145,8 +162,104 @@ func TestDeathCauseSnakeSelfCollision(t *testing.T) {
 				},
 			},
 		},
-	})
+	}, ruleOptions{})
 	require.Len(t, updates, 1)
 	require.Equal(t, DeathCauseSnakeSelfCollision, updates[0].Death.Cause)
 	require.Equal(t, int32(3), updates[0].Death.Turn)
+	require.Empty(t, updates[0].Death.KilledBy)
+}
+
+func TestDeathNoSelfCollisionWithSegmentsStackedOnHead(t *testing.T) {
+	updates := checkForDeath(&pb.Game{Width: 20, Height: 20}, &pb.GameFrame{
+		Turn: 3,
+		Snakes: []*pb.Snake{
+			{
+				ID:     "1",
+				Health: 45,
+				Body:   []*pb.Point{{X: 4, Y: 4}, {X: 4, Y: 4}, {X: 4, Y: 4}},
+			},
+		},
+	}, ruleOptions{})
+	require.Len(t, updates, 0)
+}
+
+func TestDeathCauseSnakeCollisionWithStackedTail(t *testing.T) {
+	updates := checkForDeath(&pb.Game{Width: 20, Height: 20}, &pb.GameFrame{
+		Turn: 3,
+		Snakes: []*pb.Snake{
+			{
+				ID:     "1",
+				Health: 45,
+				Body:   []*pb.Point{{X: 3, Y: 5}, {X: 3, Y: 6}},
+			},
+			{
+				ID:     "2",
+				Health: 45,
+				Body:   []*pb.Point{{X: 5, Y: 5}, {X: 4, Y: 5}, {X: 3, Y: 5}, {X: 3, Y: 5}, {X: 3, Y: 5}},
+			},
+		},
+	}, ruleOptions{})
+	require.Len(t, updates, 1)
+	require.Equal(t, "1", updates[0].Snake.ID)
+	require.Equal(t, DeathCauseSnakeCollision, updates[0].Death.Cause)
+}
+
+func TestDeathNoSquadBodyCollision(t *testing.T) {
+	frame := &pb.GameFrame{
+		Turn: 3,
+		Snakes: []*pb.Snake{
+			{
+				ID:     "1",
+				Squad:  "red",
+				Health: 45,
+				Body:   []*pb.Point{{X: 5, Y: 5}},
+			},
+			{
+				ID:     "2",
+				Squad:  "red",
+				Health: 56,
+				Body:   []*pb.Point{{X: 6, Y: 5}, {X: 5, Y: 5}},
+			},
+		},
+	}
+	updates := checkForDeath(&pb.Game{Width: 20, Height: 20}, frame, ruleOptions{allowSquadBodyCollisions: true})
+	require.Len(t, updates, 0)
+
+	frame.Snakes[1].Squad = "blue"
+	updates = checkForDeath(&pb.Game{Width: 20, Height: 20}, frame, ruleOptions{allowSquadBodyCollisions: true})
+	require.Len(t, updates, 1)
+	require.Equal(t, DeathCauseSnakeCollision, updates[0].Death.Cause)
+}
+
+func TestDeathCauseObstacleCollision(t *testing.T) {
+	updates := checkForDeath(&pb.Game{Width: 20, Height: 20}, &pb.GameFrame{
+		Turn:  3,
+		Walls: []*pb.Point{{X: 5, Y: 5}},
+		Snakes: []*pb.Snake{
+			{
+				Health: 45,
+				Body:   []*pb.Point{{X: 5, Y: 5}, {X: 5, Y: 6}},
+			},
+		},
+	}, ruleOptions{})
+	require.Len(t, updates, 1)
+	require.Equal(t, DeathCauseObstacleCollision, updates[0].Death.Cause)
+}
+
+func TestGameTickHeadToHeadWithGrowingShortSnake(t *testing.T) {
+	// with no snake servers both snakes take their default move onto the food
+	short := &pb.Snake{ID: "short", Health: 50, Body: []*pb.Point{{X: 5, Y: 6}}}
+	long := &pb.Snake{ID: "long", Health: 50, Body: []*pb.Point{{X: 5, Y: 4}, {X: 5, Y: 3}, {X: 5, Y: 2}}}
+	game := &pb.Game{Width: 10, Height: 10, MinimumLength: 1}
+	_, err := GameTick(game, &pb.GameFrame{
+		Turn:   1,
+		Food:   []*pb.Point{{X: 5, Y: 5}},
+		Snakes: []*pb.Snake{short, long},
+	})
+	require.NoError(t, err)
+
+	require.NotNil(t, short.Death)
+	require.Equal(t, DeathCauseHeadToHeadCollision, short.Death.Cause)
+	require.Equal(t, "long", short.Death.KilledBy)
+	require.Nil(t, long.Death)
 }
@@ -0,0 +1,226 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+)
+
+const (
+	// EndConditionLastStanding ends the game when SurvivorCount or fewer snakes
+	// are left alive
+	EndConditionLastStanding = "last-standing"
+	// EndConditionAllDead ends the game when no snakes are left alive, the
+	// snakes are ranked by how long they survived
+	EndConditionAllDead = "all-dead"
+	// EndConditionLength ends the game when a snake reaches TargetLength, or
+	// when at most one snake is left alive
+	EndConditionLength = "length"
+
+	// ResultReasonTargetLength is the reason a game ends when a snake reaches
+	// the target length
+	ResultReasonTargetLength = "target-length"
+
+	defaultSurvivorCount = 1
+)
+
+// EndCondition decides when a game is over. End conditions are registered by
+// name and the name is stored on the game, the condition's parameters are read
+// from the game. The game's turn limit applies whatever its end condition.
+type EndCondition interface {
+	// GameOver checks if the game has ended on the given frame.
+	GameOver(game *pb.Game, frame *pb.GameFrame) bool
+}
+
+var (
+	endConditions     = map[string]EndCondition{}
+	endConditionMutex = &sync.RWMutex{}
+)
+
+func init() {
+	RegisterEndCondition(EndConditionLastStanding, LastStandingCondition{})
+	RegisterEndCondition(EndConditionAllDead, AllDeadCondition{})
+	RegisterEndCondition(EndConditionLength, TargetLengthCondition{})
+}
+
+// RegisterEndCondition makes an end condition available under the given name,
+// registering a name twice replaces the previous end condition.
+func RegisterEndCondition(name string, condition EndCondition) {
+	endConditionMutex.Lock()
+	defer endConditionMutex.Unlock()
+
+	endConditions[name] = condition
+}
+
+// GetEndCondition returns the end condition registered under the given name.
+func GetEndCondition(name string) (EndCondition, error) {
+	endConditionMutex.RLock()
+	defer endConditionMutex.RUnlock()
+
+	condition, ok := endConditions[name]
+	if !ok {
+		return nil, fmt.Errorf("rules: unknown end condition %q", name)
+	}
+	return condition, nil
+}
+
+// validateEndCondition checks the game mode and end condition settings in a
+// create request.
+func validateEndCondition(req *pb.CreateRequest) error {
+	switch GameMode(req.Mode) {
+	case "", GameModeSurvival:
+	case GameModeMultiPlayer:
+		if len(req.Snakes) < 2 {
+			return errors.New("multi player games need at least two snakes")
+		}
+	case GameModeSinglePlayer:
+		if len(req.Snakes) > 1 {
+			return errors.New("single player games can only have one snake")
+		}
+	default:
+		return fmt.Errorf("rules: unknown game mode %q", req.Mode)
+	}
+	if req.EndCondition != "" {
+		if _, err := GetEndCondition(req.EndCondition); err != nil {
+			return err
+		}
+	}
+	if req.SurvivorCount < 0 {
+		return errors.New("survivor count must not be negative")
+	}
+	if req.TargetLength < 0 {
+		return errors.New("target length must not be negative")
+	}
+	if req.EndCondition == EndConditionLength && req.TargetLength == 0 {
+		return errors.New("the length end condition needs a target length")
+	}
+	return nil
+}
+
+// requestGameMode returns the game mode asked for in a create request, by
+// default games with one snake are single player games.
+func requestGameMode(req *pb.CreateRequest) GameMode {
+	if req.Mode != "" {
+		return GameMode(req.Mode)
+	}
+	if len(req.Snakes) == 1 {
+		return GameModeSinglePlayer
+	}
+	return GameModeMultiPlayer
+}
+
+// modeEndCondition returns the end condition games in a mode are played with
+// unless they ask for another one.
+func modeEndCondition(mode GameMode) string {
+	switch mode {
+	case GameModeSinglePlayer, GameModeSurvival:
+		return EndConditionAllDead
+	}
+	return EndConditionLastStanding
+}
+
+// endConditionName returns the end condition of a game, games created before
+// end conditions existed use the one for their mode.
+func endConditionName(game *pb.Game) string {
+	if game.EndCondition == "" {
+		return modeEndCondition(GameMode(game.Mode))
+	}
+	return game.EndCondition
+}
+
+// gameOver checks the game's end condition, an end condition that is no longer
+// registered falls back to the one for the game's mode.
+func gameOver(game *pb.Game, frame *pb.GameFrame) bool {
+	condition, err := GetEndCondition(endConditionName(game))
+	if err != nil {
+		condition, _ = GetEndCondition(modeEndCondition(GameMode(game.Mode)))
+	}
+	return condition.GameOver(game, frame)
+}
+
+func survivorCount(game *pb.Game) int32 {
+	return settingOrDefault(game.SurvivorCount, defaultSurvivorCount)
+}
+
+// LastStandingCondition ends the game when the game's SurvivorCount or fewer
+// snakes are left alive.
+type LastStandingCondition struct{}
+
+// GameOver checks if few enough snakes are left alive.
+func (LastStandingCondition) GameOver(game *pb.Game, frame *pb.GameFrame) bool {
+	return int32(len(frame.AliveSnakes())) <= survivorCount(game)
+}
+
+// AllDeadCondition ends the game when every snake is dead.
+type AllDeadCondition struct{}
+
+// GameOver checks if no snakes are left alive.
+func (AllDeadCondition) GameOver(game *pb.Game, frame *pb.GameFrame) bool {
+	return len(frame.AliveSnakes()) == 0
+}
+
+// TargetLengthCondition ends the game when a snake reaches the game's
+// TargetLength, or when at most one snake is left alive.
+type TargetLengthCondition struct{}
+
+// GameOver checks if a snake has reached the target length or the other
+// snakes have all died.
+func (TargetLengthCondition) GameOver(game *pb.Game, frame *pb.GameFrame) bool {
+	alive := frame.AliveSnakes()
+	return len(alive) <= 1 || len(reachedTargetLength(game, alive)) > 0
+}
+
+// reachedTargetLength returns the snakes that are at least the game's target
+// length.
+func reachedTargetLength(game *pb.Game, snakes []*pb.Snake) []*pb.Snake {
+	reached := []*pb.Snake{}
+	if game.TargetLength <= 0 {
+		return reached
+	}
+	for _, s := range snakes {
+		if int32(len(s.Body)) >= game.TargetLength {
+			reached = append(reached, s)
+		}
+	}
+	return reached
+}
+
+// lastToDie returns the snakes that died on the latest turn.
+func lastToDie(snakes []*pb.Snake) []*pb.Snake {
+	last := []*pb.Snake{}
+	for _, s := range snakes {
+		if s.Death == nil {
+			continue
+		}
+		if len(last) == 0 || s.Death.Turn > last[0].Death.Turn {
+			last = []*pb.Snake{s}
+		} else if s.Death.Turn == last[0].Death.Turn {
+			last = append(last, s)
+		}
+	}
+	return last
+}
+
+// survivalRanking returns the IDs of the snakes ordered by how long they
+// survived, snakes still alive first.
+func survivalRanking(frame *pb.GameFrame) []string {
+	snakes := append([]*pb.Snake{}, frame.Snakes...)
+	deathTurn := func(s *pb.Snake) int32 {
+		if s.Death == nil {
+			return frame.Turn + 1
+		}
+		return s.Death.Turn
+	}
+	sort.SliceStable(snakes, func(i, j int) bool {
+		return deathTurn(snakes[i]) > deathTurn(snakes[j])
+	})
+
+	ranking := []string{}
+	for _, s := range snakes {
+		ranking = append(ranking, s.ID)
+	}
+	return ranking
+}
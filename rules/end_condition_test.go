@@ -0,0 +1,143 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func endConditionSnakes() []*pb.Snake {
+	return []*pb.Snake{
+		{ID: "first", Body: []*pb.Point{{X: 1, Y: 1}, {X: 1, Y: 2}}},
+		{ID: "second", Body: []*pb.Point{{X: 3, Y: 1}, {X: 3, Y: 2}, {X: 3, Y: 3}}},
+		{ID: "third", Body: []*pb.Point{{X: 5, Y: 1}}},
+	}
+}
+
+func TestCreateInitialGameMode(t *testing.T) {
+	tests := []struct {
+		Mode         string
+		EndCondition string
+		Snakes       int
+		WantMode     GameMode
+		WantEnd      string
+	}{
+		{Snakes: 1, WantMode: GameModeSinglePlayer, WantEnd: EndConditionAllDead},
+		{Snakes: 2, WantMode: GameModeMultiPlayer, WantEnd: EndConditionLastStanding},
+		{Mode: string(GameModeMultiPlayer), Snakes: 3, WantMode: GameModeMultiPlayer, WantEnd: EndConditionLastStanding},
+		{Mode: string(GameModeSurvival), Snakes: 2, WantMode: GameModeSurvival, WantEnd: EndConditionAllDead},
+		{Mode: string(GameModeSurvival), EndCondition: EndConditionLastStanding, Snakes: 2, WantMode: GameModeSurvival, WantEnd: EndConditionLastStanding},
+	}
+	for _, tt := range tests {
+		req := &pb.CreateRequest{Width: 11, Height: 11, Mode: tt.Mode, EndCondition: tt.EndCondition}
+		for i := 0; i < tt.Snakes; i++ {
+			req.Snakes = append(req.Snakes, &pb.SnakeOptions{})
+		}
+		game, _, err := CreateInitialGame(req)
+		require.NoError(t, err)
+		require.Equal(t, string(tt.WantMode), game.Mode)
+		require.Equal(t, tt.WantEnd, game.EndCondition)
+		require.Equal(t, int32(1), game.SurvivorCount)
+	}
+}
+
+func TestValidateEndCondition(t *testing.T) {
+	two := []*pb.SnakeOptions{{}, {}}
+	for _, req := range []*pb.CreateRequest{
+		{Mode: "teams"},
+		{Mode: string(GameModeSinglePlayer), Snakes: two},
+		{Mode: string(GameModeMultiPlayer), Snakes: two[:1]},
+		{Mode: string(GameModeMultiPlayer)},
+		{EndCondition: "first-blood"},
+		{EndCondition: EndConditionLength},
+		{SurvivorCount: -1},
+		{TargetLength: -1},
+	} {
+		require.Error(t, validateEndCondition(req))
+	}
+	require.NoError(t, validateEndCondition(&pb.CreateRequest{EndCondition: EndConditionLength, TargetLength: 10}))
+}
+
+func TestLastStandingCondition(t *testing.T) {
+	game := &pb.Game{EndCondition: EndConditionLastStanding, SurvivorCount: 2}
+	snakes := endConditionSnakes()
+	frame := &pb.GameFrame{Snakes: snakes}
+	require.False(t, StandardRuleset{}.CheckForGameOver(game, frame))
+
+	snakes[0].Death = &pb.Death{Cause: DeathCauseWallCollision, Turn: 3}
+	require.True(t, StandardRuleset{}.CheckForGameOver(game, frame))
+	require.Equal(t, &pb.GameResult{
+		Winners: []string{"second", "third"},
+		Reason:  ResultReasonEliminated,
+	}, GetGameResult(game, frame))
+}
+
+func TestAllDeadCondition(t *testing.T) {
+	game := &pb.Game{Mode: string(GameModeSurvival)}
+	snakes := endConditionSnakes()[:2]
+	frame := &pb.GameFrame{Turn: 9, Snakes: snakes}
+
+	snakes[0].Death = &pb.Death{Cause: DeathCauseWallCollision, Turn: 5}
+	require.False(t, StandardRuleset{}.CheckForGameOver(game, frame))
+
+	snakes[1].Death = &pb.Death{Cause: DeathCauseStarvation, Turn: 9}
+	require.True(t, StandardRuleset{}.CheckForGameOver(game, frame))
+	require.Equal(t, &pb.GameResult{
+		Winners: []string{"second"},
+		Reason:  ResultReasonEliminated,
+		Ranking: []string{"second", "first"},
+	}, GetGameResult(game, frame))
+}
+
+func TestAllDeadConditionSameTurn(t *testing.T) {
+	game := &pb.Game{EndCondition: EndConditionAllDead}
+	snakes := endConditionSnakes()
+	snakes[0].Death = &pb.Death{Cause: DeathCauseHeadToHeadCollision, Turn: 7}
+	snakes[1].Death = &pb.Death{Cause: DeathCauseWallCollision, Turn: 2}
+	snakes[2].Death = &pb.Death{Cause: DeathCauseHeadToHeadCollision, Turn: 7}
+
+	result := GetGameResult(game, &pb.GameFrame{Turn: 7, Snakes: snakes})
+	require.True(t, result.Draw)
+	require.Nil(t, result.Winners)
+	require.Equal(t, []string{"first", "third", "second"}, result.Ranking)
+}
+
+func TestTargetLengthCondition(t *testing.T) {
+	game := &pb.Game{EndCondition: EndConditionLength, TargetLength: 3}
+	snakes := endConditionSnakes()
+	frame := &pb.GameFrame{Snakes: snakes}
+	require.True(t, StandardRuleset{}.CheckForGameOver(game, frame))
+	require.Equal(t, &pb.GameResult{
+		Winners: []string{"second"},
+		Reason:  ResultReasonTargetLength,
+	}, GetGameResult(game, frame))
+
+	game.TargetLength = 4
+	require.False(t, StandardRuleset{}.CheckForGameOver(game, frame))
+
+	// The last snake standing wins without reaching the target length.
+	snakes[1].Death = &pb.Death{Cause: DeathCauseWallCollision, Turn: 3}
+	snakes[2].Death = &pb.Death{Cause: DeathCauseWallCollision, Turn: 3}
+	require.True(t, StandardRuleset{}.CheckForGameOver(game, frame))
+	require.Equal(t, []string{"first"}, GetGameResult(game, frame).Winners)
+}
+
+func TestRegisterEndCondition(t *testing.T) {
+	RegisterEndCondition("turn-ten", testEndCondition{})
+	game, _, err := CreateInitialGame(&pb.CreateRequest{
+		Width:        11,
+		Height:       11,
+		EndCondition: "turn-ten",
+		Snakes:       []*pb.SnakeOptions{{}, {}},
+	})
+	require.NoError(t, err)
+	require.False(t, StandardRuleset{}.CheckForGameOver(game, &pb.GameFrame{Turn: 9, Snakes: endConditionSnakes()}))
+	require.True(t, StandardRuleset{}.CheckForGameOver(game, &pb.GameFrame{Turn: 10, Snakes: endConditionSnakes()}))
+}
+
+type testEndCondition struct{}
+
+func (testEndCondition) GameOver(game *pb.Game, frame *pb.GameFrame) bool {
+	return frame.Turn >= 10
+}
@@ -2,11 +2,12 @@ package rules
 
 import "github.com/battlesnakeio/engine/controller/pb"
 
-// CheckForGameOver checks if the game has ended. End condition is dependent on game mode.
-func CheckForGameOver(mode GameMode, gt *pb.GameFrame) bool {
-	aliveSnakes := gt.AliveSnakes()
-	if mode == GameModeSinglePlayer {
-		return len(aliveSnakes) == 0
+// CheckForGameOver checks if the game has ended on the given frame, using the
+// ruleset named on the game.
+func CheckForGameOver(game *pb.Game, frame *pb.GameFrame) (bool, error) {
+	ruleset, err := GetRuleset(game.Ruleset)
+	if err != nil {
+		return false, err
 	}
-	return len(aliveSnakes) == 1 || len(aliveSnakes) == 0
+	return ruleset.CheckForGameOver(game, frame), nil
 }
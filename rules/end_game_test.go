@@ -15,10 +15,26 @@ func TestCheckForGameOver_SinglePlayer(t *testing.T) {
 			{Death: &pb.Death{}},
 		},
 	}
-	res := CheckForGameOver(GameModeSinglePlayer, gameFrame)
+	game := &pb.Game{Mode: string(GameModeSinglePlayer)}
+	res, err := CheckForGameOver(game, gameFrame)
+	require.NoError(t, err)
 	require.True(t, res)
 
 	gameFrame.Snakes[0].Death = nil
-	res = CheckForGameOver(GameModeSinglePlayer, gameFrame)
+	res, err = CheckForGameOver(game, gameFrame)
+	require.NoError(t, err)
 	require.False(t, res)
 }
+
+func TestCheckForGameOverUsesRuleset(t *testing.T) {
+	// a scenario isn't over while its goal can still be completed, even
+	// with a single snake left
+	game := &pb.Game{Ruleset: RulesetScenario, Goal: ScenarioGoalSurvive, GoalTurns: 10}
+	frame := &pb.GameFrame{Turn: 3, Snakes: []*pb.Snake{{ID: "player", Body: []*pb.Point{{X: 1, Y: 1}}}}}
+	res, err := CheckForGameOver(game, frame)
+	require.NoError(t, err)
+	require.False(t, res)
+
+	_, err = CheckForGameOver(&pb.Game{Ruleset: "not-a-ruleset"}, frame)
+	require.Error(t, err)
+}
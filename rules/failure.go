@@ -0,0 +1,78 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+)
+
+const (
+	// FailurePolicyDefaultMove keeps snakes whose move requests fail in the
+	// game, moving them in the direction they were already heading
+	FailurePolicyDefaultMove = "default-move"
+	// FailurePolicyConsecutive eliminates snakes after MaxFailures failed move
+	// requests in a row
+	FailurePolicyConsecutive = "consecutive"
+	// FailurePolicyTotal eliminates snakes after MaxFailures failed move
+	// requests over the whole game
+	FailurePolicyTotal = "total"
+
+	defaultMaxFailures = 3
+)
+
+// validateFailurePolicy checks the failure policy settings in a create request.
+func validateFailurePolicy(req *pb.CreateRequest) error {
+	switch req.FailurePolicy {
+	case "", FailurePolicyDefaultMove, FailurePolicyConsecutive, FailurePolicyTotal:
+	default:
+		return fmt.Errorf("rules: unknown failure policy %q", req.FailurePolicy)
+	}
+	if req.MaxFailures < 0 {
+		return errors.New("max failures must not be negative")
+	}
+	return nil
+}
+
+func maxFailures(game *pb.Game) int32 {
+	return settingOrDefault(game.MaxFailures, defaultMaxFailures)
+}
+
+// moveFailure returns the death cause matching why a move request failed, or
+// an empty string when the snake made a valid move, whatever the response's
+// status.
+func moveFailure(update *SnakeUpdate) string {
+	if validMove(update) {
+		return ""
+	}
+	switch moveError(update) {
+	case MoveErrorTimeout:
+		return DeathCauseTimeout
+	case MoveErrorBadJSON, MoveErrorUnknownDirection:
+		return DeathCauseInvalidResponse
+	}
+	return DeathCauseRequestFailed
+}
+
+// recordMoveFailure updates the snake's failure counts after a move request.
+func recordMoveFailure(snake *pb.Snake, failure string) {
+	if failure == "" {
+		snake.ConsecutiveFailures = 0
+		return
+	}
+	snake.ConsecutiveFailures++
+	snake.TotalFailures++
+	snake.LastFailure = failure
+}
+
+// deathByFailures checks if a snake has failed too many move requests for the
+// game's failure policy.
+func deathByFailures(game *pb.Game, snake *pb.Snake) bool {
+	switch game.FailurePolicy {
+	case FailurePolicyConsecutive:
+		return snake.ConsecutiveFailures >= maxFailures(game)
+	case FailurePolicyTotal:
+		return snake.TotalFailures >= maxFailures(game)
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+package rules
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+	"github.com/stretchr/testify/require"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string { return "timed out" }
+func (timeoutError) Timeout() bool { return true }
+
+func TestMoveFailure(t *testing.T) {
+	badJSON := json.Unmarshal([]byte("{{"), &MoveResponse{})
+	require.Error(t, badJSON)
+
+	require.Equal(t, "", moveFailure(&SnakeUpdate{Move: "left"}))
+	require.Equal(t, DeathCauseInvalidResponse, moveFailure(&SnakeUpdate{Move: "sideways"}))
+	require.Equal(t, DeathCauseInvalidResponse, moveFailure(&SnakeUpdate{Err: badJSON}))
+	require.Equal(t, DeathCauseTimeout, moveFailure(&SnakeUpdate{Err: timeoutError{}}))
+	require.Equal(t, DeathCauseRequestFailed, moveFailure(&SnakeUpdate{Err: errors.New("connection refused")}))
+	require.Equal(t, DeathCauseRequestFailed, moveFailure(&SnakeUpdate{Err: badJSON, StatusCode: 502}))
+	// a move in a response with a bad status is still made
+	require.Equal(t, "", moveFailure(&SnakeUpdate{Move: "left", StatusCode: 500}))
+}
+
+func TestRecordMoveFailure(t *testing.T) {
+	snake := &pb.Snake{}
+	recordMoveFailure(snake, DeathCauseTimeout)
+	recordMoveFailure(snake, DeathCauseInvalidResponse)
+	require.Equal(t, int32(2), snake.ConsecutiveFailures)
+	require.Equal(t, int32(2), snake.TotalFailures)
+	require.Equal(t, DeathCauseInvalidResponse, snake.LastFailure)
+
+	recordMoveFailure(snake, "")
+	require.Equal(t, int32(0), snake.ConsecutiveFailures)
+	require.Equal(t, int32(2), snake.TotalFailures)
+}
+
+func TestDeathByFailures(t *testing.T) {
+	snake := &pb.Snake{ConsecutiveFailures: 1, TotalFailures: 3}
+	require.False(t, deathByFailures(&pb.Game{FailurePolicy: FailurePolicyDefaultMove}, snake))
+	require.False(t, deathByFailures(&pb.Game{}, snake))
+	require.False(t, deathByFailures(&pb.Game{FailurePolicy: FailurePolicyConsecutive}, snake))
+	require.True(t, deathByFailures(&pb.Game{FailurePolicy: FailurePolicyTotal}, snake))
+	require.True(t, deathByFailures(&pb.Game{FailurePolicy: FailurePolicyConsecutive, MaxFailures: 1}, snake))
+}
+
+func TestGameTickEliminatesFailingSnakes(t *testing.T) {
+	game := &pb.Game{Width: 10, Height: 10, FailurePolicy: FailurePolicyConsecutive, MaxFailures: 2}
+	snake := &pb.Snake{ID: "unreachable", Health: 50, Body: []*pb.Point{{X: 5, Y: 5}, {X: 5, Y: 6}}}
+	frame := &pb.GameFrame{Turn: 1, Snakes: []*pb.Snake{snake}}
+
+	frame, err := GameTick(game, frame)
+	require.NoError(t, err)
+	require.Nil(t, snake.Death)
+	require.Equal(t, int32(1), frame.Snakes[0].ConsecutiveFailures)
+
+	_, err = GameTick(game, frame)
+	require.NoError(t, err)
+	require.NotNil(t, snake.Death)
+	require.Equal(t, DeathCauseRequestFailed, snake.Death.Cause)
+	require.Equal(t, int32(2), snake.TotalFailures)
+}
+
+func TestGameTickDefaultMovePolicyKeepsSnakes(t *testing.T) {
+	game := &pb.Game{Width: 10, Height: 10}
+	snake := &pb.Snake{ID: "unreachable", Health: 50, Body: []*pb.Point{{X: 5, Y: 9}, {X: 5, Y: 10}}}
+	frame := &pb.GameFrame{Turn: 1, Snakes: []*pb.Snake{snake}}
+	for i := 0; i < 5; i++ {
+		var err error
+		frame, err = GameTick(game, frame)
+		require.NoError(t, err)
+	}
+	require.Nil(t, snake.Death)
+	require.Equal(t, int32(5), snake.TotalFailures)
+}
+
+func TestCreateInitialGameFailurePolicy(t *testing.T) {
+	g, _, err := CreateInitialGame(&pb.CreateRequest{Width: 10, Height: 10})
+	require.NoError(t, err)
+	require.Equal(t, FailurePolicyDefaultMove, g.FailurePolicy)
+	require.Equal(t, int32(defaultMaxFailures), g.MaxFailures)
+
+	g, _, err = CreateInitialGame(&pb.CreateRequest{Width: 10, Height: 10, FailurePolicy: FailurePolicyTotal, MaxFailures: 5})
+	require.NoError(t, err)
+	require.Equal(t, FailurePolicyTotal, g.FailurePolicy)
+	require.Equal(t, int32(5), g.MaxFailures)
+
+	_, _, err = CreateInitialGame(&pb.CreateRequest{FailurePolicy: "forgive"})
+	require.Error(t, err)
+	_, _, err = CreateInitialGame(&pb.CreateRequest{MaxFailures: -1})
+	require.Error(t, err)
+}
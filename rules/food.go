@@ -0,0 +1,196 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sync"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// FoodSpawnerReplaceEaten replaces every food that was eaten
+	FoodSpawnerReplaceEaten = "replace-eaten"
+	// FoodSpawnerMinimum keeps at least MinimumFood food on the board
+	FoodSpawnerMinimum = "minimum"
+	// FoodSpawnerProbabilistic spawns food with a chance that grows each turn
+	// without food, food always spawns after MaxTurnsToNextFoodSpawn turns
+	FoodSpawnerProbabilistic = "probabilistic"
+	// FoodSpawnerMapPoints fills the map's food spawn points every
+	// FoodSpawnInterval turns
+	FoodSpawnerMapPoints = "map-points"
+	// FoodSpawnerNone never spawns food
+	FoodSpawnerNone = "none"
+)
+
+// FoodSpawner is a strategy for adding food to the board each turn. Spawners
+// are registered by name and the name is stored on the game, the spawner's
+// parameters are read from the game.
+type FoodSpawner interface {
+	// SpawnFood returns the food to add to the next frame. The frame holds the
+	// food left on the board after snakes have eaten and the snakes still
+	// alive, eaten is the number of food eaten this turn.
+	SpawnFood(game *pb.Game, frame *pb.GameFrame, eaten int, rng *rand.Rand) []*pb.Point
+}
+
+var (
+	foodSpawners     = map[string]FoodSpawner{}
+	foodSpawnerMutex = &sync.RWMutex{}
+)
+
+func init() {
+	RegisterFoodSpawner(FoodSpawnerReplaceEaten, ReplaceEatenSpawner{})
+	RegisterFoodSpawner(FoodSpawnerMinimum, MinimumFoodSpawner{})
+	RegisterFoodSpawner(FoodSpawnerProbabilistic, ProbabilisticSpawner{})
+	RegisterFoodSpawner(FoodSpawnerMapPoints, MapPointsSpawner{})
+	RegisterFoodSpawner(FoodSpawnerNone, NoFoodSpawner{})
+}
+
+// RegisterFoodSpawner makes a food spawner available under the given name,
+// registering a name twice replaces the previous spawner.
+func RegisterFoodSpawner(name string, spawner FoodSpawner) {
+	foodSpawnerMutex.Lock()
+	defer foodSpawnerMutex.Unlock()
+
+	foodSpawners[name] = spawner
+}
+
+// GetFoodSpawner returns the food spawner registered under the given name.
+func GetFoodSpawner(name string) (FoodSpawner, error) {
+	foodSpawnerMutex.RLock()
+	defer foodSpawnerMutex.RUnlock()
+
+	spawner, ok := foodSpawners[name]
+	if !ok {
+		return nil, fmt.Errorf("rules: unknown food spawner %q", name)
+	}
+	return spawner, nil
+}
+
+// gameFoodSpawner returns the name of the food spawner for a game. Games that
+// don't name one use the probabilistic spawner when MaxTurnsToNextFoodSpawn is
+// set, and replace eaten food otherwise.
+func gameFoodSpawner(game *pb.Game) string {
+	if game.FoodSpawner != "" {
+		return game.FoodSpawner
+	}
+	if game.MaxTurnsToNextFoodSpawn > 0 {
+		return FoodSpawnerProbabilistic
+	}
+	return FoodSpawnerReplaceEaten
+}
+
+// validateFoodSpawner checks the food spawner of a new game has what it needs.
+func validateFoodSpawner(game *pb.Game) error {
+	if game.MinimumFood < 0 {
+		return errors.New("minimum food must not be negative")
+	}
+	if game.FoodSpawnInterval < 0 {
+		return errors.New("food spawn interval must not be negative")
+	}
+	name := gameFoodSpawner(game)
+	if _, err := GetFoodSpawner(name); err != nil {
+		return err
+	}
+	if name == FoodSpawnerProbabilistic && game.MaxTurnsToNextFoodSpawn <= 0 {
+		return errors.New("the probabilistic food spawner needs max turns to next food spawn")
+	}
+	if name == FoodSpawnerMapPoints && len(game.FoodSpawns) == 0 {
+		return errors.New("the map-points food spawner needs a map with food spawn points")
+	}
+	return nil
+}
+
+// spawnFood places up to count new food on free points.
+func spawnFood(game *pb.Game, frame *pb.GameFrame, count int, rng *rand.Rand) []*pb.Point {
+	occupied := append([]*pb.Point{}, frame.Food...)
+	food := []*pb.Point{}
+	for i := 0; i < count; i++ {
+		p := getFoodSpawnPoint(game, frame.Walls, occupied, frame.Snakes, rng)
+		if p == nil {
+			break
+		}
+		occupied = append(occupied, p)
+		food = append(food, p)
+	}
+	return food
+}
+
+// ReplaceEatenSpawner spawns one food for every food eaten.
+type ReplaceEatenSpawner struct{}
+
+// SpawnFood replaces the eaten food.
+func (ReplaceEatenSpawner) SpawnFood(game *pb.Game, frame *pb.GameFrame, eaten int, rng *rand.Rand) []*pb.Point {
+	return spawnFood(game, frame, eaten, rng)
+}
+
+// MinimumFoodSpawner tops the board up to MinimumFood food.
+type MinimumFoodSpawner struct{}
+
+// SpawnFood adds food until there is at least MinimumFood on the board.
+func (MinimumFoodSpawner) SpawnFood(game *pb.Game, frame *pb.GameFrame, eaten int, rng *rand.Rand) []*pb.Point {
+	return spawnFood(game, frame, int(game.MinimumFood)-len(frame.Food), rng)
+}
+
+// ProbabilisticSpawner spawns food for half the snakes alive, with a chance
+// that grows exponentially with the turns since food last spawned.
+type ProbabilisticSpawner struct{}
+
+// SpawnFood rolls for new food, food always spawns once
+// MaxTurnsToNextFoodSpawn turns have passed without any.
+func (ProbabilisticSpawner) SpawnFood(game *pb.Game, frame *pb.GameFrame, eaten int, rng *rand.Rand) []*pb.Point {
+	if game.MaxTurnsToNextFoodSpawn <= 0 {
+		return spawnFood(game, frame, eaten, rng)
+	}
+
+	count := int(math.Ceil(float64(len(frame.Snakes)) / 2.0))
+	if frame.TurnsSinceLastFoodSpawn >= game.MaxTurnsToNextFoodSpawn {
+		return spawnFood(game, frame, count, rng)
+	}
+
+	chance := rng.Int31n(1001) // use 101 here so we get 0-100 inclusive
+	calculatedChance := calculateFoodSpawnChance(game, frame.TurnsSinceLastFoodSpawn)
+	log.WithFields(log.Fields{
+		"GameID":            game.ID,
+		"Food Spawn Chance": chance,
+		"Turns Since Last":  frame.TurnsSinceLastFoodSpawn,
+		"Calculate Chance":  calculatedChance,
+	}).Info("food spawn chance")
+	if float64(chance) <= calculatedChance {
+		return spawnFood(game, frame, count, rng)
+	}
+	return nil
+}
+
+func calculateFoodSpawnChance(game *pb.Game, turnsSinceLastFoodSpawn int32) float64 {
+	minSpawnChance := float64(0.5)
+
+	ratio := math.Pow(1000/minSpawnChance, 1.0/float64(game.MaxTurnsToNextFoodSpawn-1))
+	seqNum := float64(turnsSinceLastFoodSpawn)
+
+	spawnChance := minSpawnChance * ((1 - math.Pow(ratio, seqNum)) / (1 - ratio))
+	return spawnChance
+}
+
+// MapPointsSpawner fills every free food spawn point of the map once
+// FoodSpawnInterval turns have passed since food last spawned.
+type MapPointsSpawner struct{}
+
+// SpawnFood fills the free food spawn points when the interval is up.
+func (MapPointsSpawner) SpawnFood(game *pb.Game, frame *pb.GameFrame, eaten int, rng *rand.Rand) []*pb.Point {
+	if frame.TurnsSinceLastFoodSpawn < game.FoodSpawnInterval {
+		return nil
+	}
+	return spawnFood(game, frame, len(game.FoodSpawns), rng)
+}
+
+// NoFoodSpawner never spawns food.
+type NoFoodSpawner struct{}
+
+// SpawnFood returns no food.
+func (NoFoodSpawner) SpawnFood(game *pb.Game, frame *pb.GameFrame, eaten int, rng *rand.Rand) []*pb.Point {
+	return nil
+}
@@ -0,0 +1,135 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+)
+
+const (
+	// FoodPlacementRandom places food on any free point
+	FoodPlacementRandom = "random"
+	// FoodPlacementFair places food away from every snake head, on the free
+	// points closest to equidistant between the snakes
+	FoodPlacementFair = "fair"
+
+	defaultFoodMinHeadDistance = 2
+)
+
+// validateFoodPlacement checks the food placement settings in a create request.
+func validateFoodPlacement(req *pb.CreateRequest) error {
+	switch req.FoodPlacement {
+	case "", FoodPlacementRandom, FoodPlacementFair:
+	default:
+		return fmt.Errorf("rules: unknown food placement %q", req.FoodPlacement)
+	}
+	if req.FoodMinHeadDistance < 0 {
+		return errors.New("food min head distance must not be negative")
+	}
+	return nil
+}
+
+func foodMinHeadDistance(game *pb.Game) int32 {
+	return settingOrDefault(game.FoodMinHeadDistance, defaultFoodMinHeadDistance)
+}
+
+// pickFoodPoint picks the point new food is placed on from the free points,
+// using the game's food placement.
+func pickFoodPoint(game *pb.Game, openPoints []*pb.Point, snakes []*pb.Snake, rng *rand.Rand) *pb.Point {
+	if game.FoodPlacement == FoodPlacementFair {
+		openPoints = fairFoodPoints(game, openPoints, snakes)
+	}
+	return pickRandomPoint(openPoints, rng)
+}
+
+// fairFoodPoints keeps the points at least the minimum head distance away
+// from every snake head, and of those the ones where the nearest and furthest
+// heads are closest to the same distance away. When no point is far enough
+// from the heads every point is considered, so food still spawns on crowded
+// boards.
+func fairFoodPoints(game *pb.Game, openPoints []*pb.Point, snakes []*pb.Snake) []*pb.Point {
+	heads := []*pb.Point{}
+	for _, s := range snakes {
+		if len(s.Body) > 0 {
+			heads = append(heads, s.Head())
+		}
+	}
+	if len(heads) == 0 {
+		return openPoints
+	}
+
+	type scoredPoint struct {
+		point   *pb.Point
+		nearest int32
+		spread  int32
+	}
+	scored := make([]scoredPoint, 0, len(openPoints))
+	far := false
+	for _, p := range openPoints {
+		nearest, furthest := int32(-1), int32(0)
+		for _, h := range heads {
+			d := boardDistance(game, p, h)
+			if nearest < 0 || d < nearest {
+				nearest = d
+			}
+			if d > furthest {
+				furthest = d
+			}
+		}
+		if nearest >= foodMinHeadDistance(game) {
+			far = true
+		}
+		scored = append(scored, scoredPoint{point: p, nearest: nearest, spread: furthest - nearest})
+	}
+
+	best := int32(-1)
+	for _, s := range scored {
+		if far && s.nearest < foodMinHeadDistance(game) {
+			continue
+		}
+		if best < 0 || s.spread < best {
+			best = s.spread
+		}
+	}
+	fair := []*pb.Point{}
+	for _, s := range scored {
+		if far && s.nearest < foodMinHeadDistance(game) {
+			continue
+		}
+		if s.spread == best {
+			fair = append(fair, s.point)
+		}
+	}
+	return fair
+}
+
+// boardDistance is the number of moves between two points, on a wrapped board
+// snakes can take the short way around the edge.
+func boardDistance(game *pb.Game, a, b *pb.Point) int32 {
+	dx, dy := axisDistances(game, a, b)
+	return dx + dy
+}
+
+// axisDistances returns the distance between two points along each axis, going
+// around the edge of a wrapped board when that is shorter.
+func axisDistances(game *pb.Game, a, b *pb.Point) (int32, int32) {
+	dx, dy := absInt32(a.X-b.X), absInt32(a.Y-b.Y)
+	if game.Wrapped {
+		if game.Width-dx < dx {
+			dx = game.Width - dx
+		}
+		if game.Height-dy < dy {
+			dy = game.Height - dy
+		}
+	}
+	return dx, dy
+}
+
+func absInt32(n int32) int32 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,74 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFairFoodPointsEquidistant(t *testing.T) {
+	game := &pb.Game{Width: 7, Height: 1, FoodPlacement: FoodPlacementFair}
+	snakes := []*pb.Snake{
+		{ID: "1", Body: []*pb.Point{{X: 0, Y: 0}}},
+		{ID: "2", Body: []*pb.Point{{X: 6, Y: 0}}},
+	}
+	open := getUnoccupiedPoints(game.Width, game.Height, []*pb.Point{}, snakes)
+	points := fairFoodPoints(game, open, snakes)
+	require.Len(t, points, 1)
+	require.True(t, points[0].Equal(&pb.Point{X: 3, Y: 0}))
+}
+
+func TestFairFoodPointsMinHeadDistance(t *testing.T) {
+	game := &pb.Game{Width: 5, Height: 5, FoodPlacement: FoodPlacementFair, FoodMinHeadDistance: 3}
+	snakes := []*pb.Snake{{ID: "1", Body: []*pb.Point{{X: 2, Y: 2}}}}
+	open := getUnoccupiedPoints(game.Width, game.Height, []*pb.Point{}, snakes)
+	points := fairFoodPoints(game, open, snakes)
+	require.Len(t, points, 12)
+	for _, p := range points {
+		require.True(t, boardDistance(game, p, snakes[0].Head()) >= 3)
+	}
+}
+
+func TestFairFoodPointsCrowdedBoard(t *testing.T) {
+	game := &pb.Game{Width: 2, Height: 1, FoodPlacement: FoodPlacementFair}
+	snakes := []*pb.Snake{{ID: "1", Body: []*pb.Point{{X: 0, Y: 0}}}}
+	open := getUnoccupiedPoints(game.Width, game.Height, []*pb.Point{}, snakes)
+	require.Len(t, fairFoodPoints(game, open, snakes), 1)
+}
+
+func TestBoardDistance(t *testing.T) {
+	a, b := &pb.Point{X: 0, Y: 0}, &pb.Point{X: 9, Y: 8}
+	require.Equal(t, int32(17), boardDistance(&pb.Game{Width: 10, Height: 10}, a, b))
+	require.Equal(t, int32(3), boardDistance(&pb.Game{Width: 10, Height: 10, Wrapped: true}, a, b))
+}
+
+func TestGetFoodSpawnPointFair(t *testing.T) {
+	game := &pb.Game{Width: 11, Height: 11, FoodPlacement: FoodPlacementFair}
+	snakes := []*pb.Snake{
+		{ID: "1", Body: []*pb.Point{{X: 1, Y: 1}}},
+		{ID: "2", Body: []*pb.Point{{X: 9, Y: 9}}},
+	}
+	for seed := int64(1); seed < 20; seed++ {
+		p := getFoodSpawnPoint(game, []*pb.Point{}, []*pb.Point{}, snakes, newTurnRand(seed, 1))
+		require.Equal(t, boardDistance(game, p, snakes[0].Head()), boardDistance(game, p, snakes[1].Head()))
+		require.True(t, boardDistance(game, p, snakes[0].Head()) >= defaultFoodMinHeadDistance)
+	}
+}
+
+func TestCreateInitialGameFoodPlacement(t *testing.T) {
+	g, _, err := CreateInitialGame(&pb.CreateRequest{Width: 10, Height: 10})
+	require.NoError(t, err)
+	require.Equal(t, FoodPlacementRandom, g.FoodPlacement)
+	require.Equal(t, int32(defaultFoodMinHeadDistance), g.FoodMinHeadDistance)
+
+	g, _, err = CreateInitialGame(&pb.CreateRequest{Width: 10, Height: 10, FoodPlacement: FoodPlacementFair, FoodMinHeadDistance: 4})
+	require.NoError(t, err)
+	require.Equal(t, FoodPlacementFair, g.FoodPlacement)
+	require.Equal(t, int32(4), g.FoodMinHeadDistance)
+
+	_, _, err = CreateInitialGame(&pb.CreateRequest{FoodPlacement: "lucky"})
+	require.Error(t, err)
+	_, _, err = CreateInitialGame(&pb.CreateRequest{FoodPlacement: FoodPlacementFair, FoodMinHeadDistance: -1})
+	require.Error(t, err)
+}
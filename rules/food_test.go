@@ -0,0 +1,105 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func foodTestFrame() *pb.GameFrame {
+	return &pb.GameFrame{
+		Food: []*pb.Point{{X: 0, Y: 0}},
+		Snakes: []*pb.Snake{
+			{ID: "1", Body: []*pb.Point{{X: 5, Y: 5}, {X: 5, Y: 6}}},
+			{ID: "2", Body: []*pb.Point{{X: 8, Y: 5}, {X: 8, Y: 6}}},
+			{ID: "3", Body: []*pb.Point{{X: 2, Y: 5}, {X: 2, Y: 6}}},
+		},
+	}
+}
+
+func TestGameFoodSpawnerDefaults(t *testing.T) {
+	require.Equal(t, FoodSpawnerReplaceEaten, gameFoodSpawner(&pb.Game{}))
+	require.Equal(t, FoodSpawnerProbabilistic, gameFoodSpawner(&pb.Game{MaxTurnsToNextFoodSpawn: 10}))
+	require.Equal(t, FoodSpawnerNone, gameFoodSpawner(&pb.Game{FoodSpawner: FoodSpawnerNone, MaxTurnsToNextFoodSpawn: 10}))
+}
+
+func TestReplaceEatenSpawner(t *testing.T) {
+	game := &pb.Game{Width: 10, Height: 10}
+	food := ReplaceEatenSpawner{}.SpawnFood(game, foodTestFrame(), 2, newTurnRand(1, 1))
+	require.Len(t, food, 2)
+	require.False(t, food[0].Equal(food[1]))
+}
+
+func TestMinimumFoodSpawner(t *testing.T) {
+	game := &pb.Game{Width: 10, Height: 10, MinimumFood: 4}
+	food := MinimumFoodSpawner{}.SpawnFood(game, foodTestFrame(), 0, newTurnRand(1, 1))
+	require.Len(t, food, 3)
+
+	game.MinimumFood = 1
+	require.Len(t, MinimumFoodSpawner{}.SpawnFood(game, foodTestFrame(), 1, newTurnRand(1, 1)), 0)
+}
+
+func TestProbabilisticSpawner(t *testing.T) {
+	game := &pb.Game{Width: 10, Height: 10, MaxTurnsToNextFoodSpawn: 5}
+	frame := foodTestFrame()
+	frame.TurnsSinceLastFoodSpawn = 5
+	// half the snakes, rounded up
+	require.Len(t, ProbabilisticSpawner{}.SpawnFood(game, frame, 0, newTurnRand(1, 1)), 2)
+
+	game.MaxTurnsToNextFoodSpawn = 1000
+	frame.TurnsSinceLastFoodSpawn = 0
+	require.Len(t, ProbabilisticSpawner{}.SpawnFood(game, frame, 0, newTurnRand(1, 1)), 0)
+}
+
+func TestMapPointsSpawner(t *testing.T) {
+	game := &pb.Game{
+		Width:             10,
+		Height:            10,
+		FoodSpawnInterval: 3,
+		FoodSpawns:        []*pb.Point{{X: 0, Y: 0}, {X: 9, Y: 9}, {X: 5, Y: 5}, {X: 9, Y: 0}},
+	}
+	frame := foodTestFrame()
+	frame.TurnsSinceLastFoodSpawn = 2
+	require.Len(t, MapPointsSpawner{}.SpawnFood(game, frame, 0, newTurnRand(1, 1)), 0)
+
+	// the points with food or a snake on them are skipped
+	frame.TurnsSinceLastFoodSpawn = 3
+	food := MapPointsSpawner{}.SpawnFood(game, frame, 0, newTurnRand(1, 1))
+	require.Len(t, food, 2)
+	require.True(t, containsPoint(food, &pb.Point{X: 9, Y: 9}))
+	require.True(t, containsPoint(food, &pb.Point{X: 9, Y: 0}))
+}
+
+func TestNoFoodSpawner(t *testing.T) {
+	game := &pb.Game{Width: 10, Height: 10}
+	require.Len(t, NoFoodSpawner{}.SpawnFood(game, foodTestFrame(), 3, newTurnRand(1, 1)), 0)
+}
+
+func TestUpdateFoodUnknownSpawner(t *testing.T) {
+	err := updateFood(&pb.Game{FoodSpawner: "not-a-spawner"}, foodTestFrame(), &pb.GameFrame{}, nil, newTurnRand(1, 1))
+	require.Error(t, err)
+}
+
+func TestCreateInitialGameFoodSpawner(t *testing.T) {
+	g, _, err := CreateInitialGame(&pb.CreateRequest{Width: 10, Height: 10, Food: 4, FoodSpawner: FoodSpawnerMinimum})
+	require.NoError(t, err)
+	require.Equal(t, FoodSpawnerMinimum, g.FoodSpawner)
+	require.Equal(t, int32(4), g.MinimumFood)
+
+	g, _, err = CreateInitialGame(&pb.CreateRequest{Width: 10, Height: 10, MaxTurnsToNextFoodSpawn: 10})
+	require.NoError(t, err)
+	require.Equal(t, FoodSpawnerProbabilistic, g.FoodSpawner)
+
+	reqs := []*pb.CreateRequest{
+		{FoodSpawner: "not-a-spawner"},
+		{FoodSpawner: FoodSpawnerProbabilistic},
+		{FoodSpawner: FoodSpawnerMapPoints},
+		{FoodSpawner: FoodSpawnerMinimum, MinimumFood: -1},
+		{FoodSpawner: FoodSpawnerMapPoints, FoodSpawnInterval: -1},
+	}
+	for _, req := range reqs {
+		_, _, err := CreateInitialGame(req)
+		require.Error(t, err)
+	}
+}
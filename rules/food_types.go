@@ -0,0 +1,109 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+)
+
+const (
+	// FoodTypeNormal restores health and grows the snake by one segment
+	FoodTypeNormal = "normal"
+	// FoodTypeGolden restores health and grows the snake by extra segments
+	FoodTypeGolden = "golden"
+	// FoodTypePoison costs the snake health
+	FoodTypePoison = "poison"
+	// FoodTypeShrink removes segments from the snake's tail
+	FoodTypeShrink = "shrink"
+
+	goldenFoodExtraLength = 2
+	poisonFoodDamage      = 25
+	shrinkFoodLength      = 2
+)
+
+// foodTypes is the order food types are picked in, so a seeded game always
+// spawns the same food.
+var foodTypes = []string{FoodTypeNormal, FoodTypeGolden, FoodTypePoison, FoodTypeShrink}
+
+// validateFoodWeights checks the food type spawn weights in a create request.
+func validateFoodWeights(req *pb.CreateRequest) error {
+	total := int32(0)
+	for foodType, weight := range req.FoodWeights {
+		if !isFoodType(foodType) {
+			return fmt.Errorf("rules: unknown food type %q", foodType)
+		}
+		if weight < 0 {
+			return errors.New("food weights must not be negative")
+		}
+		total += weight
+	}
+	if len(req.FoodWeights) > 0 && total == 0 {
+		return errors.New("food weights must allow at least one food type")
+	}
+	return nil
+}
+
+func isFoodType(foodType string) bool {
+	for _, t := range foodTypes {
+		if t == foodType {
+			return true
+		}
+	}
+	return false
+}
+
+// pickFoodType picks the type of a new food using the game's food weights,
+// only normal food spawns in games without weights.
+func pickFoodType(game *pb.Game, rng *rand.Rand) string {
+	total := int32(0)
+	for _, weight := range game.FoodWeights {
+		total += weight
+	}
+	if total <= 0 {
+		return FoodTypeNormal
+	}
+
+	n := rng.Int31n(total)
+	for _, t := range foodTypes {
+		if n < game.FoodWeights[t] {
+			return t
+		}
+		n -= game.FoodWeights[t]
+	}
+	return FoodTypeNormal
+}
+
+// typeFood picks a type for each new food, returning the food items for the
+// food that isn't normal food.
+func typeFood(game *pb.Game, food []*pb.Point, rng *rand.Rand) []*pb.FoodItem {
+	items := []*pb.FoodItem{}
+	for _, f := range food {
+		if t := pickFoodType(game, rng); t != FoodTypeNormal {
+			items = append(items, &pb.FoodItem{Point: f.Clone(), Type: t})
+		}
+	}
+	return items
+}
+
+// foodType returns the type of the food at the point.
+func foodType(frame *pb.GameFrame, p *pb.Point) string {
+	for _, item := range frame.FoodItems {
+		if item.Point.Equal(p) {
+			return item.Type
+		}
+	}
+	return FoodTypeNormal
+}
+
+// remainingFoodItems returns the food items for the food still on the board.
+func remainingFoodItems(items []*pb.FoodItem, food []*pb.Point) []*pb.FoodItem {
+	remaining := []*pb.FoodItem{}
+	for _, item := range items {
+		if containsPoint(food, item.Point) {
+			remaining = append(remaining, item)
+		}
+	}
+	return remaining
+}
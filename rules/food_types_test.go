@@ -0,0 +1,117 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func eatingSnake() *pb.Snake {
+	return &pb.Snake{
+		Health: 50,
+		Body: []*pb.Point{
+			{X: 2, Y: 1},
+			{X: 1, Y: 1},
+			{X: 1, Y: 2},
+			{X: 2, Y: 2},
+			{X: 3, Y: 2},
+		},
+	}
+}
+
+func eatFood(snake *pb.Snake, foodType string) []*pb.Point {
+	food := &pb.Point{X: 2, Y: 1}
+	return checkForSnakesEating(&pb.Game{}, &pb.GameFrame{
+		Food:      []*pb.Point{food},
+		FoodItems: []*pb.FoodItem{{Point: food.Clone(), Type: foodType}},
+		Snakes:    []*pb.Snake{snake},
+	}, false)
+}
+
+func TestEatGoldenFood(t *testing.T) {
+	snake := eatingSnake()
+	require.Len(t, eatFood(snake, FoodTypeGolden), 1)
+	require.Len(t, snake.Body, 5+goldenFoodExtraLength)
+	require.Equal(t, int32(defaultFoodHealth), snake.Health)
+}
+
+func TestEatPoisonFood(t *testing.T) {
+	snake := eatingSnake()
+	require.Len(t, eatFood(snake, FoodTypePoison), 1)
+	require.Len(t, snake.Body, 4)
+	require.Equal(t, int32(50-poisonFoodDamage), snake.Health)
+}
+
+func TestEatShrinkFood(t *testing.T) {
+	snake := eatingSnake()
+	require.Len(t, eatFood(snake, FoodTypeShrink), 1)
+	require.Len(t, snake.Body, 4-shrinkFoodLength)
+	require.Equal(t, int32(50), snake.Health)
+
+	snake = &pb.Snake{Health: 50, Body: []*pb.Point{{X: 2, Y: 1}, {X: 1, Y: 1}}}
+	eatFood(snake, FoodTypeShrink)
+	require.Len(t, snake.Body, 1)
+}
+
+func TestPoisonFoodStarves(t *testing.T) {
+	snake := eatingSnake()
+	snake.Health = poisonFoodDamage
+	frame := &pb.GameFrame{Snakes: []*pb.Snake{snake}}
+	eatFood(snake, FoodTypePoison)
+	updates := checkForDeath(&pb.Game{Width: 10, Height: 10}, frame, ruleOptions{})
+	require.Len(t, updates, 1)
+	require.Equal(t, DeathCauseStarvation, updates[0].Death.Cause)
+}
+
+func TestPickFoodType(t *testing.T) {
+	rng := newTurnRand(1, 1)
+	require.Equal(t, FoodTypeNormal, pickFoodType(&pb.Game{}, rng))
+	require.Equal(t, FoodTypeGolden, pickFoodType(&pb.Game{FoodWeights: map[string]int32{FoodTypeGolden: 1}}, rng))
+
+	game := &pb.Game{FoodWeights: map[string]int32{FoodTypeNormal: 1, FoodTypePoison: 1, FoodTypeShrink: 0}}
+	seen := map[string]bool{}
+	for i := 0; i < 50; i++ {
+		seen[pickFoodType(game, rng)] = true
+	}
+	require.Equal(t, map[string]bool{FoodTypeNormal: true, FoodTypePoison: true}, seen)
+}
+
+func TestUpdateFoodKeepsFoodItems(t *testing.T) {
+	game := &pb.Game{Width: 10, Height: 10, FoodWeights: map[string]int32{FoodTypeGolden: 1}}
+	next := &pb.GameFrame{}
+	err := updateFood(game, &pb.GameFrame{
+		Food: []*pb.Point{{X: 1, Y: 1}, {X: 2, Y: 2}},
+		FoodItems: []*pb.FoodItem{
+			{Point: &pb.Point{X: 1, Y: 1}, Type: FoodTypePoison},
+			{Point: &pb.Point{X: 2, Y: 2}, Type: FoodTypeShrink},
+		},
+	}, next, []*pb.Point{{X: 2, Y: 2}}, newTurnRand(1, 1))
+	require.NoError(t, err)
+	require.Len(t, next.Food, 2)
+	require.Len(t, next.FoodItems, 2)
+	require.Equal(t, FoodTypePoison, foodType(next, next.Food[0]))
+	require.Equal(t, FoodTypeGolden, foodType(next, next.Food[1]))
+}
+
+func TestCreateInitialGameFoodWeights(t *testing.T) {
+	g, frames, err := CreateInitialGame(&pb.CreateRequest{
+		Width:       10,
+		Height:      10,
+		Food:        3,
+		FoodWeights: map[string]int32{FoodTypeShrink: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]int32{FoodTypeShrink: 1}, g.FoodWeights)
+	require.Len(t, frames[0].FoodItems, 3)
+
+	reqs := []*pb.CreateRequest{
+		{FoodWeights: map[string]int32{"spicy": 1}},
+		{FoodWeights: map[string]int32{FoodTypeGolden: -1}},
+		{FoodWeights: map[string]int32{FoodTypeGolden: 0}},
+	}
+	for _, req := range reqs {
+		_, _, err := CreateInitialGame(req)
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,200 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+)
+
+const (
+	// MapGeneratorObstacles scatters walls across the board
+	MapGeneratorObstacles = "obstacles"
+	// MapGeneratorMaze builds corridors between a grid of wall pillars
+	MapGeneratorMaze = "maze"
+
+	obstacleWallChance  = 0.15
+	mazeWallChance      = 0.5
+	maxGenerateAttempts = 10
+)
+
+// generateMap builds a random map for a board of the given size. The layout is
+// mirrored across both axes of the board and the starts are mirror images of
+// each other, so every snake starts in the same surroundings. An odd number of
+// snakes needs a board with an odd width and height, so one snake can start in
+// the centre. Every start can
+// reach every other start through open cells, and open cells that can't be
+// reached are walled off so that food never spawns out of reach.
+func generateMap(generator string, width, height int32, snakes int, rng *rand.Rand) (*Map, error) {
+	if generator != MapGeneratorObstacles && generator != MapGeneratorMaze {
+		return nil, fmt.Errorf("unknown map generator %q", generator)
+	}
+	if width <= 0 || height <= 0 {
+		return nil, errors.New("generated maps need a board width and height")
+	}
+
+	if snakes%2 == 1 && (width%2 == 0 || height%2 == 0) {
+		return nil, fmt.Errorf("generated maps for %d snakes need a board with an odd width and height", snakes)
+	}
+	starts := symmetricStarts(width, height, snakes, rng)
+	if len(starts) < snakes {
+		return nil, fmt.Errorf("board is too small to generate a map for %d snakes", snakes)
+	}
+
+	m := &Map{
+		Name:   "generated-" + generator,
+		Width:  width,
+		Height: height,
+		Starts: convertPoints(starts),
+	}
+	for attempt := 0; attempt < maxGenerateAttempts; attempt++ {
+		walls := generateWalls(generator, width, height, starts, rng)
+		reachable := reachableCells(width, height, walls, starts[0])
+		if !allReachable(width, reachable, starts) {
+			continue
+		}
+
+		m.Walls = []Coords{}
+		for y := int32(0); y < height; y++ {
+			for x := int32(0); x < width; x++ {
+				if !reachable[y*width+x] {
+					m.Walls = append(m.Walls, Coords{X: x, Y: y})
+				}
+			}
+		}
+		return m, nil
+	}
+
+	// An open board always connects the starts.
+	return m, nil
+}
+
+// mirrorPoints returns a point and its mirror images across both axes of the
+// board.
+func mirrorPoints(p *pb.Point, width, height int32) []*pb.Point {
+	return []*pb.Point{
+		{X: p.X, Y: p.Y},
+		{X: width - 1 - p.X, Y: height - 1 - p.Y},
+		{X: width - 1 - p.X, Y: p.Y},
+		{X: p.X, Y: height - 1 - p.Y},
+	}
+}
+
+// symmetricStarts picks start points in the top left quarter of the board and
+// adds their mirror images across both axes, or only the opposite corner for
+// the last two snakes, so the starts look the same with the board turned half
+// way round. An odd number of snakes has one start in the centre of the board.
+// Starts are kept at least minStartSpacing moves apart, including from their
+// own mirror images.
+func symmetricStarts(width, height int32, snakes int, rng *rand.Rand) []*pb.Point {
+	candidates := []*pb.Point{}
+	for x := int32(1); x < width/2; x++ {
+		for y := int32(1); y < height/2; y++ {
+			candidates = append(candidates, &pb.Point{X: x, Y: y})
+		}
+	}
+
+	starts := []*pb.Point{}
+	if snakes%2 == 1 {
+		starts = append(starts, &pb.Point{X: width / 2, Y: height / 2})
+	}
+	for len(starts) < snakes && len(candidates) > 0 {
+		i := rng.Intn(len(candidates))
+		p := candidates[i]
+		candidates = append(candidates[:i], candidates[i+1:]...)
+		mirrors := mirrorPoints(p, width, height)
+		if snakes-len(starts) < len(mirrors) {
+			mirrors = mirrors[:2]
+		}
+		picked := append(append([]*pb.Point{}, starts...), mirrors...)
+		if spacedOut(picked) {
+			starts = picked
+		}
+	}
+	return starts
+}
+
+// spacedOut checks the points are all at least minStartSpacing moves apart.
+func spacedOut(points []*pb.Point) bool {
+	for i, a := range points {
+		for _, b := range points[:i] {
+			if absInt32(a.X-b.X)+absInt32(a.Y-b.Y) < minStartSpacing {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// generateWalls lays out walls in the top left quarter of the board, including
+// the centre lines, and mirrors them to the rest of the board. Cells next to
+// the starts, or to their mirror images, are kept clear.
+func generateWalls(generator string, width, height int32, starts []*pb.Point, rng *rand.Rand) []bool {
+	keepClear := []*pb.Point{}
+	for _, s := range starts {
+		for _, p := range []*pb.Point{s, {X: s.X + 1, Y: s.Y}, {X: s.X - 1, Y: s.Y}, {X: s.X, Y: s.Y + 1}, {X: s.X, Y: s.Y - 1}} {
+			keepClear = append(keepClear, mirrorPoints(p, width, height)...)
+		}
+	}
+
+	walls := make([]bool, width*height)
+	for x := int32(0); x <= (width-1)/2; x++ {
+		for y := int32(0); y <= (height-1)/2; y++ {
+			p := &pb.Point{X: x, Y: y}
+			if containsPoint(keepClear, p) || !isGeneratedWall(generator, p, rng) {
+				continue
+			}
+			for _, m := range mirrorPoints(p, width, height) {
+				walls[m.Y*width+m.X] = true
+			}
+		}
+	}
+	return walls
+}
+
+func isGeneratedWall(generator string, p *pb.Point, rng *rand.Rand) bool {
+	if generator == MapGeneratorMaze {
+		oddX, oddY := p.X%2 == 1, p.Y%2 == 1
+		if oddX && oddY {
+			return true
+		}
+		if oddX != oddY {
+			return rng.Float64() < mazeWallChance
+		}
+		return false
+	}
+	return rng.Float64() < obstacleWallChance
+}
+
+// reachableCells flood fills the open cells of the board from a point.
+func reachableCells(width, height int32, walls []bool, from *pb.Point) []bool {
+	reachable := make([]bool, width*height)
+	reachable[from.Y*width+from.X] = true
+	queue := []*pb.Point{from}
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+		for _, n := range []*pb.Point{{X: p.X + 1, Y: p.Y}, {X: p.X - 1, Y: p.Y}, {X: p.X, Y: p.Y + 1}, {X: p.X, Y: p.Y - 1}} {
+			if n.X < 0 || n.X >= width || n.Y < 0 || n.Y >= height {
+				continue
+			}
+			i := n.Y*width + n.X
+			if walls[i] || reachable[i] {
+				continue
+			}
+			reachable[i] = true
+			queue = append(queue, n)
+		}
+	}
+	return reachable
+}
+
+func allReachable(width int32, reachable []bool, points []*pb.Point) bool {
+	for _, p := range points {
+		if !reachable[p.Y*width+p.X] {
+			return false
+		}
+	}
+	return true
+}
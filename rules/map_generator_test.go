@@ -0,0 +1,100 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateMapIsFairAndConnected(t *testing.T) {
+	sizes := [][2]int32{{7, 7}, {11, 11}, {19, 19}, {8, 12}, {10, 10}}
+	for _, generator := range []string{MapGeneratorObstacles, MapGeneratorMaze} {
+		for _, size := range sizes {
+			for seed := int64(1); seed <= 20; seed++ {
+				m, err := generateMap(generator, size[0], size[1], 4, newTurnRand(seed, 0))
+				require.NoError(t, err)
+				require.NoError(t, m.validate())
+				require.Len(t, m.Starts, 4)
+
+				walls := m.walls()
+				grid := make([]bool, m.Width*m.Height)
+				for _, w := range walls {
+					grid[w.Y*m.Width+w.X] = true
+					for _, mirror := range mirrorPoints(w, m.Width, m.Height) {
+						require.True(t, containsPoint(walls, mirror), "walls must be symmetric")
+					}
+				}
+				starts := m.starts()
+				require.Equal(t, mirrorPoints(starts[0], m.Width, m.Height), starts)
+				require.True(t, spacedOut(starts), "starts must not be next to each other")
+
+				// every open cell can be reached from every start
+				reachable := reachableCells(m.Width, m.Height, grid, starts[0])
+				for i := range grid {
+					require.True(t, grid[i] != reachable[i])
+				}
+			}
+		}
+	}
+}
+
+func TestGenerateMapStartsSpacedOut(t *testing.T) {
+	for seed := int64(1); seed <= 20; seed++ {
+		starts := symmetricStarts(10, 10, 8, newTurnRand(seed, 0))
+		require.Len(t, starts, 8)
+		require.True(t, spacedOut(starts))
+	}
+}
+
+func TestGenerateMapStartsWholeMirrorGroups(t *testing.T) {
+	for snakes := 1; snakes <= 8; snakes++ {
+		for seed := int64(1); seed <= 10; seed++ {
+			m, err := generateMap(MapGeneratorObstacles, 11, 11, snakes, newTurnRand(seed, 0))
+			require.NoError(t, err)
+			starts := m.starts()
+			require.Len(t, starts, snakes)
+			require.True(t, spacedOut(starts))
+			// every start has its opposite corner as another start, so the
+			// starts look the same with the board turned half way round
+			for _, p := range starts {
+				require.True(t, containsPoint(starts, &pb.Point{X: 10 - p.X, Y: 10 - p.Y}), "%d snakes", snakes)
+			}
+		}
+	}
+
+	_, err := generateMap(MapGeneratorObstacles, 10, 11, 3, newTurnRand(1, 0))
+	require.Error(t, err)
+}
+
+func TestGenerateMapErrors(t *testing.T) {
+	_, err := generateMap("spiral", 11, 11, 2, newTurnRand(1, 0))
+	require.Error(t, err)
+	_, err = generateMap(MapGeneratorMaze, 0, 0, 2, newTurnRand(1, 0))
+	require.Error(t, err)
+	_, err = generateMap(MapGeneratorMaze, 3, 3, 2, newTurnRand(1, 0))
+	require.Error(t, err)
+}
+
+func TestCreateInitialGameWithMapGenerator(t *testing.T) {
+	req := &pb.CreateRequest{
+		Width:        11,
+		Height:       11,
+		Seed:         42,
+		MapGenerator: MapGeneratorMaze,
+		Snakes:       []*pb.SnakeOptions{{ID: "one"}, {ID: "two"}},
+	}
+	g, frames, err := CreateInitialGame(req)
+	require.NoError(t, err)
+	require.Equal(t, "generated-maze", g.Map)
+	require.NotEmpty(t, frames[0].Walls)
+	require.Equal(t, &pb.Point{X: 10 - frames[0].Snakes[0].Head().X, Y: 10 - frames[0].Snakes[0].Head().Y}, frames[0].Snakes[1].Head())
+
+	_, again, err := CreateInitialGame(req)
+	require.NoError(t, err)
+	require.Equal(t, frames[0].Walls, again[0].Walls)
+
+	req.Map = "test-box"
+	_, _, err = CreateInitialGame(req)
+	require.Error(t, err)
+}
@@ -0,0 +1,141 @@
+package rules
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+)
+
+// Map is a custom board that games can be played on. Maps are loaded from JSON
+// files and referenced by name in the create request.
+//
+//	{
+//	  "name": "box",
+//	  "width": 7,
+//	  "height": 7,
+//	  "walls": [{"x": 3, "y": 3}],
+//	  "starts": [{"x": 1, "y": 1}, {"x": 5, "y": 5}],
+//	  "foodSpawns": [{"x": 1, "y": 5}, {"x": 5, "y": 1}]
+//	}
+//
+// Walls are impassable cells, snakes are placed on the start slots in order and
+// food only spawns on the food spawn points. When a map has no start slots
+// snakes are placed randomly, and without food spawn points food can spawn on
+// any free cell.
+type Map struct {
+	Name       string   `json:"name"`
+	Width      int32    `json:"width"`
+	Height     int32    `json:"height"`
+	Walls      []Coords `json:"walls"`
+	Starts     []Coords `json:"starts"`
+	FoodSpawns []Coords `json:"foodSpawns"`
+}
+
+var (
+	maps     = map[string]*Map{}
+	mapMutex = &sync.RWMutex{}
+)
+
+// RegisterMap validates a map and makes it available under its name,
+// registering a name twice replaces the previous map.
+func RegisterMap(m *Map) error {
+	if err := m.validate(); err != nil {
+		return err
+	}
+
+	mapMutex.Lock()
+	defer mapMutex.Unlock()
+
+	maps[m.Name] = m
+	return nil
+}
+
+// GetMap returns the map registered under the given name.
+func GetMap(name string) (*Map, error) {
+	mapMutex.RLock()
+	defer mapMutex.RUnlock()
+
+	m, ok := maps[name]
+	if !ok {
+		return nil, fmt.Errorf("rules: unknown map %q", name)
+	}
+	return m, nil
+}
+
+// LoadMap reads a map from a JSON file.
+func LoadMap(path string) (*Map, error) {
+	data, err := ioutil.ReadFile(path) // nolint: gosec
+	if err != nil {
+		return nil, err
+	}
+	m := &Map{}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("rules: invalid map %s: %v", path, err)
+	}
+	return m, nil
+}
+
+// LoadMaps registers every map in the .json files of a directory.
+func LoadMaps(dir string) error {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		m, err := LoadMap(path)
+		if err != nil {
+			return err
+		}
+		if err := RegisterMap(m); err != nil {
+			return fmt.Errorf("rules: invalid map %s: %v", path, err)
+		}
+	}
+	return nil
+}
+
+func (m *Map) validate() error {
+	if m.Name == "" {
+		return errors.New("map must have a name")
+	}
+	if m.Width <= 0 || m.Height <= 0 {
+		return errors.New("map must have a width and height")
+	}
+	walls := m.walls()
+	for _, p := range append(append(walls, m.starts()...), m.foodSpawns()...) {
+		if p.X < 0 || p.X >= m.Width || p.Y < 0 || p.Y >= m.Height {
+			return fmt.Errorf("map point %d,%d is outside the board", p.X, p.Y)
+		}
+	}
+	for _, p := range append(m.starts(), m.foodSpawns()...) {
+		if containsPoint(walls, p) {
+			return fmt.Errorf("map point %d,%d is on a wall", p.X, p.Y)
+		}
+	}
+	return nil
+}
+
+func (m *Map) walls() []*pb.Point      { return coordsToPoints(m.Walls) }
+func (m *Map) starts() []*pb.Point     { return coordsToPoints(m.Starts) }
+func (m *Map) foodSpawns() []*pb.Point { return coordsToPoints(m.FoodSpawns) }
+
+func coordsToPoints(coords []Coords) []*pb.Point {
+	points := []*pb.Point{}
+	for _, c := range coords {
+		points = append(points, &pb.Point{X: c.X, Y: c.Y})
+	}
+	return points
+}
+
+func containsPoint(points []*pb.Point, p *pb.Point) bool {
+	for _, o := range points {
+		if o.Equal(p) {
+			return true
+		}
+	}
+	return false
+}
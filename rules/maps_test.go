@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func testMap() *Map {
+	return &Map{
+		Name:       "test-box",
+		Width:      7,
+		Height:     7,
+		Walls:      []Coords{{X: 3, Y: 3}, {X: 3, Y: 4}},
+		Starts:     []Coords{{X: 1, Y: 1}, {X: 5, Y: 5}},
+		FoodSpawns: []Coords{{X: 1, Y: 5}, {X: 5, Y: 1}},
+	}
+}
+
+func TestRegisterMapInvalid(t *testing.T) {
+	maps := []*Map{
+		{Width: 7, Height: 7},
+		{Name: "no-size"},
+		{Name: "wall-outside", Width: 7, Height: 7, Walls: []Coords{{X: 7, Y: 0}}},
+		{Name: "start-on-wall", Width: 7, Height: 7, Walls: []Coords{{X: 1, Y: 1}}, Starts: []Coords{{X: 1, Y: 1}}},
+	}
+	for _, m := range maps {
+		require.Error(t, RegisterMap(m), m.Name)
+	}
+	_, err := GetMap("start-on-wall")
+	require.Error(t, err)
+}
+
+func TestLoadMaps(t *testing.T) {
+	dir, err := ioutil.TempDir("", "maps")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir) // nolint: errcheck
+
+	data := `{"name": "loaded", "width": 5, "height": 5, "walls": [{"x": 2, "y": 2}]}`
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "loaded.json"), []byte(data), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "README.md"), []byte("not a map"), 0600))
+
+	require.NoError(t, LoadMaps(dir))
+	m, err := GetMap("loaded")
+	require.NoError(t, err)
+	require.Equal(t, int32(5), m.Width)
+	require.Equal(t, []Coords{{X: 2, Y: 2}}, m.Walls)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "broken.json"), []byte("{"), 0600))
+	require.Error(t, LoadMaps(dir))
+}
+
+func TestCreateInitialGameOnMap(t *testing.T) {
+	require.NoError(t, RegisterMap(testMap()))
+
+	g, frames, err := CreateInitialGame(&pb.CreateRequest{
+		Map:    "test-box",
+		Food:   5,
+		Snakes: []*pb.SnakeOptions{{ID: "one"}, {ID: "two"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "test-box", g.Map)
+	require.Equal(t, int32(7), g.Width)
+	require.Equal(t, int32(7), g.Height)
+	require.Equal(t, []*pb.Point{{X: 1, Y: 5}, {X: 5, Y: 1}}, g.FoodSpawns)
+	require.Equal(t, []*pb.Point{{X: 3, Y: 3}, {X: 3, Y: 4}}, frames[0].Walls)
+	require.Equal(t, &pb.Point{X: 1, Y: 1}, frames[0].Snakes[0].Head())
+	require.Equal(t, &pb.Point{X: 5, Y: 5}, frames[0].Snakes[1].Head())
+	// only the two spawn points can have food
+	require.Len(t, frames[0].Food, 2)
+	for _, f := range frames[0].Food {
+		require.True(t, containsPoint(g.FoodSpawns, f))
+	}
+}
+
+func TestCreateInitialGameOnMapErrors(t *testing.T) {
+	require.NoError(t, RegisterMap(testMap()))
+
+	_, _, err := CreateInitialGame(&pb.CreateRequest{Map: "not-a-map"})
+	require.Error(t, err)
+	_, _, err = CreateInitialGame(&pb.CreateRequest{Map: "test-box", Width: 11, Height: 11})
+	require.Error(t, err)
+	_, _, err = CreateInitialGame(&pb.CreateRequest{
+		Map:    "test-box",
+		Snakes: []*pb.SnakeOptions{{ID: "one"}, {ID: "two"}, {ID: "three"}},
+	})
+	require.Error(t, err)
+}
+
+func TestGetFoodSpawnPointAvoidsWalls(t *testing.T) {
+	game := &pb.Game{Width: 2, Height: 1}
+	walls := []*pb.Point{{X: 0, Y: 0}}
+	p := getFoodSpawnPoint(game, walls, []*pb.Point{}, []*pb.Snake{}, newTurnRand(1, 1))
+	require.Equal(t, &pb.Point{X: 1, Y: 0}, p)
+
+	p = getFoodSpawnPoint(game, walls, []*pb.Point{{X: 1, Y: 0}}, []*pb.Snake{}, newTurnRand(1, 1))
+	require.Nil(t, p)
+}
+
+func TestGameTickKeepsWalls(t *testing.T) {
+	game := &pb.Game{Width: 7, Height: 7}
+	walls := []*pb.Point{{X: 3, Y: 3}}
+	next, err := GameTick(game, &pb.GameFrame{Turn: 1, Walls: walls})
+	require.NoError(t, err)
+	require.Equal(t, walls, next.Walls)
+}
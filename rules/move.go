@@ -7,49 +7,137 @@ import (
 	"github.com/battlesnakeio/engine/controller/pb"
 )
 
+const (
+	// MoveErrorTimeout is when the snake didn't respond in time
+	MoveErrorTimeout = "timeout"
+	// MoveErrorHTTPStatus is when the snake responded with a non 2xx status
+	MoveErrorHTTPStatus = "http-status"
+	// MoveErrorBadJSON is when the snake's response couldn't be decoded
+	MoveErrorBadJSON = "bad-json"
+	// MoveErrorUnknownDirection is when the snake's move wasn't up, down, left
+	// or right
+	MoveErrorUnknownDirection = "unknown-direction"
+	// MoveErrorRequestFailed is when the request couldn't be made at all
+	MoveErrorRequestFailed = "request-failed"
+
+	// maxRecordedResponse is how much of a response body that couldn't be used
+	// is kept on the move result
+	maxRecordedResponse = 256
+)
+
 // SnakeUpdate bundles together a snake with a move for processing
 type SnakeUpdate struct {
-	Snake   *pb.Snake
-	Latency time.Duration
-	Move    string
-	Err     error
+	Snake      *pb.Snake
+	Latency    time.Duration
+	Move       string
+	Err        error
+	StatusCode int
+	Response   string
 }
 
 func toSnakeUpdate(resp snakeResponse) *SnakeUpdate {
-	if resp.err == nil {
-		moveResponse := MoveResponse{}
-		err := json.Unmarshal(resp.data, &moveResponse)
-		if err != nil {
-			return &SnakeUpdate{
-				Snake:   resp.snake,
-				Latency: resp.latency,
-				Err:     err,
-			}
+	update := &SnakeUpdate{
+		Snake:      resp.snake,
+		Latency:    resp.latency,
+		StatusCode: resp.statusCode,
+		Response:   string(resp.data),
+		Err:        resp.err,
+	}
+	if resp.err != nil {
+		return update
+	}
+
+	moveResponse := MoveResponse{}
+	err := json.Unmarshal(resp.data, &moveResponse)
+	if err != nil {
+		update.Err = err
+		return update
+	}
+	update.Move = moveResponse.Move
+	return update
+}
+
+// badStatus checks if a response had a non 2xx status, 0 means there was no
+// response.
+func badStatus(code int) bool {
+	return code != 0 && (code < 200 || code >= 300)
+}
+
+// validMove checks if the snake's response gave a move it can make. A move in
+// a response with a non 2xx status is still made.
+func validMove(update *SnakeUpdate) bool {
+	if update.Err != nil {
+		return false
+	}
+	switch update.Move {
+	case "up", "down", "left", "right":
+		return true
+	}
+	return false
+}
+
+// moveError returns the category of what went wrong with a move request, or an
+// empty string when the snake made a valid move with a 2xx response.
+func moveError(update *SnakeUpdate) string {
+	if update.Err == nil {
+		if badStatus(update.StatusCode) {
+			return MoveErrorHTTPStatus
 		}
-		return &SnakeUpdate{
-			Snake:   resp.snake,
-			Latency: resp.latency,
-			Move:    moveResponse.Move,
+		if validMove(update) {
+			return ""
 		}
+		return MoveErrorUnknownDirection
 	}
 
-	return &SnakeUpdate{
-		Snake:   resp.snake,
-		Latency: resp.latency,
-		Err:     resp.err,
+	if timeout, ok := update.Err.(interface{ Timeout() bool }); ok && timeout.Timeout() {
+		return MoveErrorTimeout
+	}
+	switch update.Err.(type) {
+	case *json.SyntaxError, *json.UnmarshalTypeError:
+		if badStatus(update.StatusCode) {
+			return MoveErrorHTTPStatus
+		}
+		return MoveErrorBadJSON
+	}
+	return MoveErrorRequestFailed
+}
+
+// moveResult records the snake's response to a move request on the frame.
+func moveResult(update *SnakeUpdate) *pb.MoveResult {
+	result := &pb.MoveResult{
+		Move:       update.Move,
+		Valid:      validMove(update),
+		Error:      moveError(update),
+		StatusCode: int32(update.StatusCode),
+	}
+	if result.Error == MoveErrorHTTPStatus || result.Error == MoveErrorBadJSON {
+		result.Response = update.Response
+		if len(result.Response) > maxRecordedResponse {
+			result.Response = result.Response[:maxRecordedResponse]
+		}
 	}
+	return result
 }
 
-// GatherSnakeMoves goes and queries each snake for the snake move
+// GatherSnakeMoves goes and queries each snake for the snake move, scripted
+// snakes make their next scripted move instead
 func GatherSnakeMoves(timeout time.Duration, game *pb.Game, gameFrame *pb.GameFrame) []*SnakeUpdate {
-	responses := gatherAliveSnakeResponses(multiSnakeRequest{
+	ret := []*SnakeUpdate{}
+	snakes := []*pb.Snake{}
+	for _, s := range gameFrame.AliveSnakes() {
+		if len(scriptedMoves(game, s)) > 0 {
+			ret = append(ret, scriptedUpdate(game, s, gameFrame.Turn+1))
+			continue
+		}
+		snakes = append(snakes, s)
+	}
+
+	responses := gatherSnakeResponses(multiSnakeRequest{
 		url:     "move",
 		timeout: timeout,
 		game:    game,
 		frame:   gameFrame,
-	})
-
-	ret := []*SnakeUpdate{}
+	}, snakes)
 	for _, resp := range responses {
 		ret = append(ret, toSnakeUpdate(resp))
 	}
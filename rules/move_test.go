@@ -1,6 +1,8 @@
 package rules
 
 import (
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -52,3 +54,80 @@ func gatherMoveResponses(t *testing.T, json string, updates chan<- *SnakeUpdate)
 		}
 	}()
 }
+
+func TestToSnakeUpdateStatusCode(t *testing.T) {
+	update := toSnakeUpdate(snakeResponse{
+		snake:      &pb.Snake{ID: "1"},
+		data:       []byte(`{"move":"up"}`),
+		statusCode: 500,
+	})
+	require.NoError(t, update.Err)
+	require.Equal(t, "up", update.Move)
+	require.Equal(t, MoveErrorHTTPStatus, moveError(update))
+
+	result := moveResult(update)
+	require.True(t, result.Valid)
+	require.Equal(t, MoveErrorHTTPStatus, result.Error)
+	require.Equal(t, `{"move":"up"}`, result.Response)
+
+	badJSON := toSnakeUpdate(snakeResponse{data: []byte("oops"), statusCode: 502})
+	require.Error(t, badJSON.Err)
+	require.Equal(t, MoveErrorHTTPStatus, moveError(badJSON))
+	require.False(t, moveResult(badJSON).Valid)
+}
+
+func TestGameTickUsesMoveWithBadStatus(t *testing.T) {
+	createClient = singleEndpointMockClient(t, "http://not.a.snake.com/move", `{"move":"left"}`, 500)
+	defer func() { createClient = getNetClient }()
+
+	snake := &pb.Snake{ID: "1", URL: "http://not.a.snake.com", Health: 50, Body: []*pb.Point{{X: 5, Y: 5}, {X: 5, Y: 6}}}
+	game := &pb.Game{Width: 10, Height: 10, FailurePolicy: FailurePolicyConsecutive, MaxFailures: 1}
+	next, err := GameTick(game, &pb.GameFrame{Turn: 1, Snakes: []*pb.Snake{snake}})
+	require.NoError(t, err)
+	require.True(t, snake.Head().Equal(&pb.Point{X: 4, Y: 5}))
+	require.Nil(t, snake.Death)
+	require.Equal(t, int32(0), snake.ConsecutiveFailures)
+	require.Equal(t, MoveErrorHTTPStatus, next.Snakes[0].MoveResult.Error)
+	require.Equal(t, int32(500), next.Snakes[0].MoveResult.StatusCode)
+}
+
+func TestMoveError(t *testing.T) {
+	badJSON := toSnakeUpdate(snakeResponse{data: []byte("{{"), statusCode: 200})
+	require.Equal(t, MoveErrorBadJSON, moveError(badJSON))
+	require.Equal(t, "", moveError(&SnakeUpdate{Move: "down"}))
+	require.Equal(t, MoveErrorUnknownDirection, moveError(&SnakeUpdate{Move: "UP"}))
+	require.Equal(t, MoveErrorTimeout, moveError(&SnakeUpdate{Err: timeoutError{}}))
+	require.Equal(t, MoveErrorRequestFailed, moveError(&SnakeUpdate{Err: errors.New("connection refused")}))
+}
+
+func TestMoveResult(t *testing.T) {
+	result := moveResult(&SnakeUpdate{Move: "left", StatusCode: 200, Response: `{"move":"left"}`})
+	require.Equal(t, &pb.MoveResult{Move: "left", Valid: true, StatusCode: 200}, result)
+
+	result = moveResult(&SnakeUpdate{Move: "north", StatusCode: 200, Response: `{"move":"north"}`})
+	require.False(t, result.Valid)
+	require.Equal(t, "north", result.Move)
+	require.Equal(t, MoveErrorUnknownDirection, result.Error)
+
+	long := strings.Repeat("x", 1000)
+	result = moveResult(toSnakeUpdate(snakeResponse{data: []byte(long), statusCode: 200}))
+	require.Equal(t, MoveErrorBadJSON, result.Error)
+	require.Equal(t, long[:maxRecordedResponse], result.Response)
+}
+
+func TestGameTickRecordsMoveResults(t *testing.T) {
+	createClient = singleEndpointMockClient(t, "http://not.a.snake.com/move", `{"move":"sideways"}`, 200)
+	defer func() { createClient = getNetClient }()
+
+	snake := &pb.Snake{ID: "1", URL: "http://not.a.snake.com", Health: 50, Body: []*pb.Point{{X: 5, Y: 5}, {X: 5, Y: 6}}}
+	next, err := GameTick(&pb.Game{Width: 10, Height: 10}, &pb.GameFrame{Turn: 1, Snakes: []*pb.Snake{snake}})
+	require.NoError(t, err)
+	result := next.Snakes[0].MoveResult
+	require.NotNil(t, result)
+	require.Equal(t, "sideways", result.Move)
+	require.False(t, result.Valid)
+	require.Equal(t, MoveErrorUnknownDirection, result.Error)
+	require.Equal(t, int32(200), result.StatusCode)
+	// the snake still takes its default move
+	require.True(t, snake.Head().Equal(&pb.Point{X: 5, Y: 4}))
+}
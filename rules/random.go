@@ -0,0 +1,34 @@
+package rules
+
+import (
+	"math/rand"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// turnSeedMixer spreads turn numbers across the seed space so that nearby game
+// seeds don't share turn generators.
+const turnSeedMixer = int64(0x5DEECE66D)
+
+// newGameSeed returns a seed for a game that did not ask for one.
+func newGameSeed() int64 {
+	return time.Now().UTC().UnixNano()
+}
+
+// newTurnRand returns the random number generator for a single turn of a game.
+// It only depends on the game seed and the turn, so replaying a game with the
+// same seed and moves produces the same frames, even when the game is picked up
+// by another worker.
+func newTurnRand(seed int64, turn int32) *rand.Rand {
+	return rand.New(rand.NewSource(seed ^ (int64(turn) * turnSeedMixer)))
+}
+
+// newSnakeID generates a version 4 uuid from the game random number generator.
+func newSnakeID(rng *rand.Rand) string {
+	id := uuid.UUID{}
+	rng.Read(id[:]) // nolint: errcheck, rand.Rand.Read never returns an error
+	id.SetVersion(uuid.V4)
+	id.SetVariant(uuid.VariantRFC4122)
+	return id.String()
+}
@@ -0,0 +1,66 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateInitialGameSameSeed(t *testing.T) {
+	req := &pb.CreateRequest{
+		Width:  15,
+		Height: 15,
+		Food:   5,
+		Seed:   1234,
+		Snakes: []*pb.SnakeOptions{
+			{Name: "snake 1"},
+			{Name: "snake 2"},
+		},
+	}
+	g1, frames1, err := CreateInitialGame(req)
+	require.NoError(t, err)
+	g2, frames2, err := CreateInitialGame(req)
+	require.NoError(t, err)
+
+	require.Equal(t, int64(1234), g1.Seed)
+	require.Equal(t, g1.Seed, g2.Seed)
+	require.Equal(t, frames1, frames2)
+}
+
+func TestCreateInitialGameGeneratesSeed(t *testing.T) {
+	g, _, err := CreateInitialGame(&pb.CreateRequest{})
+	require.NoError(t, err)
+	require.NotZero(t, g.Seed)
+}
+
+func TestGameTickSameSeed(t *testing.T) {
+	game := &pb.Game{
+		Width:                   15,
+		Height:                  15,
+		Seed:                    99,
+		MaxTurnsToNextFoodSpawn: 5,
+	}
+	frame := &pb.GameFrame{
+		Turn: 3,
+		Snakes: []*pb.Snake{
+			{
+				ID:     "1",
+				Health: 100,
+				Body:   []*pb.Point{{X: 5, Y: 5}, {X: 5, Y: 6}, {X: 5, Y: 7}},
+			},
+		},
+	}
+
+	next1, err := GameTick(proto.Clone(game).(*pb.Game), proto.Clone(frame).(*pb.GameFrame))
+	require.NoError(t, err)
+	next2, err := GameTick(proto.Clone(game).(*pb.Game), proto.Clone(frame).(*pb.GameFrame))
+	require.NoError(t, err)
+	require.Equal(t, next1, next2)
+}
+
+func TestNewSnakeID(t *testing.T) {
+	require.Equal(t, newSnakeID(newTurnRand(1, 0)), newSnakeID(newTurnRand(1, 0)))
+	require.NotEqual(t, newSnakeID(newTurnRand(1, 0)), newSnakeID(newTurnRand(2, 0)))
+}
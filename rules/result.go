@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+)
+
+const (
+	// TiebreakerDraw declares a draw when the turn limit is reached
+	TiebreakerDraw = "draw"
+	// TiebreakerLongest picks the longest snakes alive when the turn limit is
+	// reached
+	TiebreakerLongest = "longest"
+	// TiebreakerHealth picks the healthiest snakes alive when the turn limit is
+	// reached
+	TiebreakerHealth = "health"
+)
+
+const (
+	// ResultReasonEliminated is the reason a game ends when there are not enough
+	// snakes left alive to keep playing
+	ResultReasonEliminated = "eliminated"
+	// ResultReasonMaxTurns is the reason a game ends when it reaches its turn limit
+	ResultReasonMaxTurns = "max-turns"
+)
+
+func validateTiebreaker(tiebreaker string) error {
+	switch tiebreaker {
+	case "", TiebreakerDraw, TiebreakerLongest, TiebreakerHealth:
+		return nil
+	}
+	return fmt.Errorf("unknown tiebreaker %q", tiebreaker)
+}
+
+// maxTurnsReached checks if the game has played all the turns it is allowed.
+func maxTurnsReached(game *pb.Game, frame *pb.GameFrame) bool {
+	return game.MaxTurns > 0 && frame.Turn >= game.MaxTurns
+}
+
+// GetGameResult works out the result of a game that has ended on the given
+// frame. The snakes left alive win, unless the game ran out of turns before its
+// end condition was met in which case the game's tiebreaker picks the winners. Games played until a snake
+// reaches a target length are won by the longest snake that reached it, and
+// games played until every snake is dead are won by the snakes that died last
+// and rank the snakes by how long they survived. It's a draw when no snakes
+// win, or the winners are in more squads than the game allows to survive.
+// Scenarios are won by completing their goal. Single player games also record
+// the snake's score.
+func GetGameResult(game *pb.Game, frame *pb.GameFrame) *pb.GameResult {
+	if game.Ruleset == RulesetScenario {
+		return scenarioResult(game, frame)
+	}
+	alive := frame.AliveSnakes()
+	condition := endConditionName(game)
+	result := &pb.GameResult{Reason: ResultReasonEliminated}
+	winningSquads := 1
+	switch {
+	case condition == EndConditionLength && len(reachedTargetLength(game, alive)) > 0:
+		result.Reason = ResultReasonTargetLength
+		alive = breakTie(TiebreakerLongest, reachedTargetLength(game, alive))
+	case maxTurnsReached(game, frame) && len(alive) > 0 && !gameOver(game, frame):
+		result.Reason = ResultReasonMaxTurns
+		alive = breakTie(game.Tiebreaker, alive)
+	case condition == EndConditionLastStanding:
+		winningSquads = int(survivorCount(game))
+	case condition == EndConditionAllDead && len(alive) == 0 && len(frame.Snakes) > 1:
+		alive = lastToDie(frame.Snakes)
+	}
+	if condition == EndConditionAllDead && len(frame.Snakes) > 1 {
+		result.Ranking = survivalRanking(frame)
+	}
+
+	squads := map[string]bool{}
+	for _, s := range alive {
+		result.Winners = append(result.Winners, s.ID)
+		squads[squadOf(s)] = true
+	}
+	result.Draw = len(squads) == 0 || len(squads) > winningSquads
+	if result.Draw {
+		result.Winners = nil
+	}
+	if GameMode(game.Mode) == GameModeSinglePlayer {
+		result.Score = soloScore(game, frame)
+	}
+	return result
+}
+
+// breakTie returns the snakes that win by the given tiebreaker.
+func breakTie(tiebreaker string, snakes []*pb.Snake) []*pb.Snake {
+	var score func(s *pb.Snake) int32
+	switch tiebreaker {
+	case TiebreakerLongest:
+		score = func(s *pb.Snake) int32 { return int32(len(s.Body)) }
+	case TiebreakerHealth:
+		score = func(s *pb.Snake) int32 { return s.Health }
+	default:
+		return nil
+	}
+
+	best := []*pb.Snake{}
+	for _, s := range snakes {
+		if len(best) == 0 || score(s) > score(best[0]) {
+			best = []*pb.Snake{s}
+		} else if score(s) == score(best[0]) {
+			best = append(best, s)
+		}
+	}
+	return best
+}
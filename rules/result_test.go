@@ -0,0 +1,96 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func resultSnakes() []*pb.Snake {
+	return []*pb.Snake{
+		{ID: "long", Health: 50, Body: []*pb.Point{{X: 1, Y: 1}, {X: 1, Y: 2}, {X: 1, Y: 3}, {X: 1, Y: 4}}},
+		{ID: "healthy", Health: 90, Body: []*pb.Point{{X: 3, Y: 1}, {X: 3, Y: 2}, {X: 3, Y: 3}}},
+		{ID: "dead", Health: 100, Body: []*pb.Point{{X: 5, Y: 1}}, Death: &pb.Death{Cause: DeathCauseStarvation}},
+	}
+}
+
+func TestStandardCheckForGameOverMaxTurns(t *testing.T) {
+	game := &pb.Game{Mode: string(GameModeMultiPlayer), MaxTurns: 10}
+	require.False(t, StandardRuleset{}.CheckForGameOver(game, &pb.GameFrame{Turn: 9, Snakes: resultSnakes()}))
+	require.True(t, StandardRuleset{}.CheckForGameOver(game, &pb.GameFrame{Turn: 10, Snakes: resultSnakes()}))
+
+	game.MaxTurns = 0
+	require.False(t, StandardRuleset{}.CheckForGameOver(game, &pb.GameFrame{Turn: 1000, Snakes: resultSnakes()}))
+}
+
+func TestGetGameResultLastSnakeStanding(t *testing.T) {
+	snakes := resultSnakes()
+	snakes[0].Death = &pb.Death{Cause: DeathCauseWallCollision}
+	result := GetGameResult(&pb.Game{}, &pb.GameFrame{Turn: 5, Snakes: snakes})
+	require.Equal(t, &pb.GameResult{
+		Winners: []string{"healthy"},
+		Reason:  ResultReasonEliminated,
+	}, result)
+}
+
+func TestGetGameResultNoSurvivors(t *testing.T) {
+	snakes := resultSnakes()
+	snakes[0].Death = &pb.Death{Cause: DeathCauseHeadToHeadCollision}
+	snakes[1].Death = &pb.Death{Cause: DeathCauseHeadToHeadCollision}
+	result := GetGameResult(&pb.Game{}, &pb.GameFrame{Turn: 5, Snakes: snakes})
+	require.Equal(t, &pb.GameResult{Draw: true, Reason: ResultReasonEliminated}, result)
+}
+
+func TestGetGameResultTiebreakers(t *testing.T) {
+	tests := []struct {
+		tiebreaker string
+		expected   *pb.GameResult
+	}{
+		{TiebreakerLongest, &pb.GameResult{Winners: []string{"long"}, Reason: ResultReasonMaxTurns}},
+		{TiebreakerHealth, &pb.GameResult{Winners: []string{"healthy"}, Reason: ResultReasonMaxTurns}},
+		{TiebreakerDraw, &pb.GameResult{Draw: true, Reason: ResultReasonMaxTurns}},
+	}
+	for _, test := range tests {
+		game := &pb.Game{MaxTurns: 10, Tiebreaker: test.tiebreaker}
+		result := GetGameResult(game, &pb.GameFrame{Turn: 10, Snakes: resultSnakes()})
+		require.Equal(t, test.expected, result, test.tiebreaker)
+	}
+}
+
+func TestGetGameResultSurvivorOnFinalTurn(t *testing.T) {
+	snakes := resultSnakes()
+	snakes[0].Death = &pb.Death{Cause: DeathCauseWallCollision, Turn: 10}
+	game := &pb.Game{MaxTurns: 10, Tiebreaker: TiebreakerDraw}
+	result := GetGameResult(game, &pb.GameFrame{Turn: 10, Snakes: snakes})
+	require.Equal(t, &pb.GameResult{
+		Winners: []string{"healthy"},
+		Reason:  ResultReasonEliminated,
+	}, result)
+}
+
+func TestGetGameResultTiebreakerTied(t *testing.T) {
+	snakes := resultSnakes()
+	snakes[1].Health = 50
+	game := &pb.Game{MaxTurns: 10, Tiebreaker: TiebreakerHealth}
+	result := GetGameResult(game, &pb.GameFrame{Turn: 10, Snakes: snakes})
+	require.Equal(t, &pb.GameResult{Draw: true, Reason: ResultReasonMaxTurns}, result)
+
+	// squad-mates tied on health win together
+	snakes[0].Squad = "red"
+	snakes[1].Squad = "red"
+	result = GetGameResult(game, &pb.GameFrame{Turn: 10, Snakes: snakes})
+	require.Equal(t, &pb.GameResult{Winners: []string{"long", "healthy"}, Reason: ResultReasonMaxTurns}, result)
+}
+
+func TestCreateInitialGameTurnLimit(t *testing.T) {
+	g, _, err := CreateInitialGame(&pb.CreateRequest{MaxTurns: 200})
+	require.NoError(t, err)
+	require.Equal(t, int32(200), g.MaxTurns)
+	require.Equal(t, TiebreakerDraw, g.Tiebreaker)
+
+	_, _, err = CreateInitialGame(&pb.CreateRequest{MaxTurns: -1})
+	require.Error(t, err)
+	_, _, err = CreateInitialGame(&pb.CreateRequest{MaxTurns: 200, Tiebreaker: "coin-flip"})
+	require.Error(t, err)
+}
@@ -0,0 +1,107 @@
+package rules
+
+import (
+	"errors"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+)
+
+const (
+	// RulesetRoyale is the name of the royale ruleset
+	RulesetRoyale = "royale"
+
+	defaultHazardDamage      = 15
+	defaultShrinkEveryNTurns = 25
+)
+
+func init() {
+	RegisterRuleset(RulesetRoyale, RoyaleRuleset{})
+}
+
+// RoyaleRuleset plays the standard game on a board that shrinks over time. Every
+// ShrinkEveryNTurns turns another ring of cells around the edge of the board
+// becomes hazardous, snakes with their head in a hazard lose HazardDamage extra
+// health each turn.
+type RoyaleRuleset struct {
+	StandardRuleset
+}
+
+// CreateInitialGame creates a new royale game, the board starts without hazards.
+func (r RoyaleRuleset) CreateInitialGame(req *pb.CreateRequest) (*pb.Game, []*pb.GameFrame, error) {
+	if req.HazardDamage < 0 {
+		return nil, nil, errors.New("hazard damage must not be negative")
+	}
+	if req.ShrinkEveryNTurns < 0 {
+		return nil, nil, errors.New("shrink every n turns must not be negative")
+	}
+
+	game, frames, err := r.StandardRuleset.CreateInitialGame(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	game.HazardDamage = req.HazardDamage
+	if game.HazardDamage == 0 {
+		game.HazardDamage = defaultHazardDamage
+	}
+	game.ShrinkEveryNTurns = req.ShrinkEveryNTurns
+	if game.ShrinkEveryNTurns == 0 {
+		game.ShrinkEveryNTurns = defaultShrinkEveryNTurns
+	}
+	return game, frames, nil
+}
+
+// GameTick runs the standard game tick, which damages snakes in the current
+// hazards, and then shrinks the board for the next turn.
+func (r RoyaleRuleset) GameTick(game *pb.Game, lastFrame *pb.GameFrame) (*pb.GameFrame, error) {
+	nextFrame, err := r.StandardRuleset.GameTick(game, lastFrame)
+	if err != nil {
+		return nil, err
+	}
+	nextFrame.Hazards = royaleHazards(game, nextFrame.Turn)
+	return nextFrame, nil
+}
+
+// royaleHazards returns the hazards for a turn, a new ring is added every
+// ShrinkEveryNTurns turns until only the centre of the board is left.
+func royaleHazards(game *pb.Game, turn int32) []*pb.Point {
+	if game.ShrinkEveryNTurns <= 0 {
+		return nil
+	}
+
+	rings := turn / game.ShrinkEveryNTurns
+	maxRings := (min(game.Width, game.Height) - 1) / 2
+	if rings > maxRings {
+		rings = maxRings
+	}
+
+	hazards := []*pb.Point{}
+	for x := int32(0); x < game.Width; x++ {
+		for y := int32(0); y < game.Height; y++ {
+			edge := min(min(x, y), min(game.Width-1-x, game.Height-1-y))
+			if edge < rings {
+				hazards = append(hazards, &pb.Point{X: x, Y: y})
+			}
+		}
+	}
+	return hazards
+}
+
+func isHazard(p *pb.Point, hazards []*pb.Point) bool {
+	if p == nil {
+		return false
+	}
+	for _, h := range hazards {
+		if h.Equal(p) {
+			return true
+		}
+	}
+	return false
+}
+
+func min(a, b int32) int32 {
+	if a < b {
+		return a
+	}
+	return b
+}
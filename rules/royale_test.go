@@ -0,0 +1,85 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoyaleCreateInitialGameDefaults(t *testing.T) {
+	g, frames, err := CreateInitialGame(&pb.CreateRequest{
+		Width:   11,
+		Height:  11,
+		Ruleset: RulesetRoyale,
+	})
+	require.NoError(t, err)
+	require.Equal(t, RulesetRoyale, g.Ruleset)
+	require.Equal(t, int32(defaultHazardDamage), g.HazardDamage)
+	require.Equal(t, int32(defaultShrinkEveryNTurns), g.ShrinkEveryNTurns)
+	require.Len(t, frames[0].Hazards, 0)
+}
+
+func TestRoyaleCreateInitialGameInvalidSettings(t *testing.T) {
+	_, _, err := CreateInitialGame(&pb.CreateRequest{
+		Width:        11,
+		Height:       11,
+		Ruleset:      RulesetRoyale,
+		HazardDamage: -1,
+	})
+	require.Error(t, err)
+}
+
+func TestRoyaleHazards(t *testing.T) {
+	game := &pb.Game{Width: 5, Height: 5, ShrinkEveryNTurns: 10}
+
+	require.Len(t, royaleHazards(game, 9), 0)
+	// the outer ring of a 5x5 board
+	hazards := royaleHazards(game, 10)
+	require.Len(t, hazards, 16)
+	require.True(t, isHazard(&pb.Point{X: 0, Y: 2}, hazards))
+	require.False(t, isHazard(&pb.Point{X: 1, Y: 1}, hazards))
+	// everything but the centre
+	require.Len(t, royaleHazards(game, 20), 24)
+	require.Len(t, royaleHazards(game, 100), 24)
+}
+
+func TestGameTickHazardDamage(t *testing.T) {
+	game := &pb.Game{Width: 5, Height: 5, HazardDamage: 15}
+	snakes := []*pb.Snake{
+		{
+			ID:     "in-hazard",
+			Health: 50,
+			Body:   []*pb.Point{{X: 0, Y: 2}, {X: 0, Y: 3}, {X: 0, Y: 4}},
+		},
+		{
+			ID:     "starving",
+			Health: 10,
+			Body:   []*pb.Point{{X: 4, Y: 2}, {X: 4, Y: 3}, {X: 4, Y: 4}},
+		},
+	}
+	next, err := GameTick(game, &pb.GameFrame{
+		Turn:    1,
+		Snakes:  snakes,
+		Hazards: []*pb.Point{{X: 0, Y: 1}, {X: 4, Y: 1}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(34), next.Snakes[0].Health)
+	require.Nil(t, next.Snakes[0].Death)
+	require.NotNil(t, next.Snakes[1].Death)
+	require.Equal(t, DeathCauseHazard, next.Snakes[1].Death.Cause)
+	require.Len(t, next.Hazards, 2)
+}
+
+func TestRoyaleGameTickShrinks(t *testing.T) {
+	game := &pb.Game{
+		Width:             5,
+		Height:            5,
+		Ruleset:           RulesetRoyale,
+		HazardDamage:      15,
+		ShrinkEveryNTurns: 2,
+	}
+	next, err := GameTick(game, &pb.GameFrame{Turn: 1})
+	require.NoError(t, err)
+	require.Len(t, next.Hazards, 16)
+}
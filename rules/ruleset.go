@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+)
+
+// RulesetStandard is the name of the default ruleset, it is used when a game
+// does not ask for a specific ruleset.
+const RulesetStandard = "standard"
+
+// Ruleset is the set of rules a game is played by. It covers setting up a new
+// game, running each tick and checking if the game is over. Rulesets are
+// registered by name and the name is stored on the game so that any worker can
+// pick up the right implementation.
+type Ruleset interface {
+	// CreateInitialGame creates a new game and the starting frames based on the
+	// create request passed in.
+	CreateInitialGame(req *pb.CreateRequest) (*pb.Game, []*pb.GameFrame, error)
+	// GameTick runs the game one tick and returns the next frame.
+	GameTick(game *pb.Game, lastFrame *pb.GameFrame) (*pb.GameFrame, error)
+	// CheckForGameOver checks if the game has ended.
+	CheckForGameOver(game *pb.Game, frame *pb.GameFrame) bool
+}
+
+var (
+	rulesets     = map[string]Ruleset{}
+	rulesetMutex = &sync.RWMutex{}
+)
+
+func init() {
+	RegisterRuleset(RulesetStandard, StandardRuleset{})
+}
+
+// RegisterRuleset makes a ruleset available under the given name, registering
+// a name twice replaces the previous ruleset.
+func RegisterRuleset(name string, ruleset Ruleset) {
+	rulesetMutex.Lock()
+	defer rulesetMutex.Unlock()
+
+	rulesets[name] = ruleset
+}
+
+// GetRuleset returns the ruleset registered under the given name, an empty name
+// returns the standard ruleset.
+func GetRuleset(name string) (Ruleset, error) {
+	if name == "" {
+		name = RulesetStandard
+	}
+
+	rulesetMutex.RLock()
+	defer rulesetMutex.RUnlock()
+
+	ruleset, ok := rulesets[name]
+	if !ok {
+		return nil, fmt.Errorf("rules: unknown ruleset %q", name)
+	}
+	return ruleset, nil
+}
+
+// StandardRuleset is the classic game, snakes play on an open board until there
+// is at most one snake left alive.
+type StandardRuleset struct{}
+
+// ruleOptions switch parts of the standard rules on or off, for rulesets that
+// change how a tick plays out rather than adding to it.
+type ruleOptions struct {
+	// growEveryTurn grows every snake every turn and keeps them at full
+	// health, whether they ate or not.
+	growEveryTurn bool
+	// noFood keeps food off the board for the whole game, no food is placed at
+	// the start and the game uses the none food spawner.
+	noFood bool
+	// allowSquadBodyCollisions lets snakes move through their squad-mates'
+	// bodies.
+	allowSquadBodyCollisions bool
+	// sharedElimination kills the rest of a squad when one of its snakes dies.
+	sharedElimination bool
+	// sharedHealth and sharedLength bring every snake in a squad up to the
+	// health and length of the best off squad-mate.
+	sharedHealth bool
+	sharedLength bool
+}
+
+// CheckForGameOver checks if the game has met its end condition, or has reached
+// its turn limit.
+func (StandardRuleset) CheckForGameOver(game *pb.Game, frame *pb.GameFrame) bool {
+	return maxTurnsReached(game, frame) || gameOver(game, frame)
+}
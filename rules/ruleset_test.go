@@ -0,0 +1,54 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+	"github.com/stretchr/testify/require"
+)
+
+type countingRuleset struct {
+	StandardRuleset
+	ticks int
+}
+
+func (r *countingRuleset) GameTick(game *pb.Game, lastFrame *pb.GameFrame) (*pb.GameFrame, error) {
+	r.ticks++
+	return &pb.GameFrame{Turn: lastFrame.Turn + 1}, nil
+}
+
+func TestGetRulesetDefaultsToStandard(t *testing.T) {
+	ruleset, err := GetRuleset("")
+	require.NoError(t, err)
+	require.Equal(t, StandardRuleset{}, ruleset)
+}
+
+func TestGetRulesetUnknown(t *testing.T) {
+	_, err := GetRuleset("not-a-ruleset")
+	require.Error(t, err)
+}
+
+func TestCreateInitialGameUnknownRuleset(t *testing.T) {
+	_, _, err := CreateInitialGame(&pb.CreateRequest{Ruleset: "not-a-ruleset"})
+	require.Error(t, err)
+}
+
+func TestCreateInitialGameRecordsRuleset(t *testing.T) {
+	g, _, err := CreateInitialGame(&pb.CreateRequest{})
+	require.NoError(t, err)
+	require.Equal(t, RulesetStandard, g.Ruleset)
+}
+
+func TestGameTickUsesRegisteredRuleset(t *testing.T) {
+	ruleset := &countingRuleset{}
+	RegisterRuleset("counting", ruleset)
+
+	g, _, err := CreateInitialGame(&pb.CreateRequest{Ruleset: "counting"})
+	require.NoError(t, err)
+	require.Equal(t, "counting", g.Ruleset)
+
+	next, err := GameTick(g, &pb.GameFrame{Turn: 2})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), next.Turn)
+	require.Equal(t, 1, ruleset.ticks)
+}
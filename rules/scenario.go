@@ -0,0 +1,348 @@
+package rules
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+)
+
+const (
+	// RulesetScenario is the name of the scenario ruleset
+	RulesetScenario = "scenario"
+
+	// ScenarioGoalEatAllFood is completed when the snakes have eaten all the
+	// food, within the goal's turns when it has any
+	ScenarioGoalEatAllFood = "eat-all-food"
+	// ScenarioGoalSurvive is completed when a snake is still alive after the
+	// goal's turns
+	ScenarioGoalSurvive = "survive"
+
+	// ResultReasonGoalCompleted is the reason a scenario ends when the goal is
+	// completed
+	ResultReasonGoalCompleted = "goal-completed"
+	// ResultReasonGoalFailed is the reason a scenario ends when the goal can no
+	// longer be completed
+	ResultReasonGoalFailed = "goal-failed"
+)
+
+func init() {
+	RegisterRuleset(RulesetScenario, ScenarioRuleset{})
+}
+
+// Scenario is an authored starting position with a goal for the snakes to
+// complete. Scenarios are loaded from JSON files and referenced by name in the
+// create request.
+//
+//	{
+//	  "name": "first-meal",
+//	  "width": 5,
+//	  "height": 5,
+//	  "goal": {"type": "eat-all-food", "turns": 10},
+//	  "food": [{"x": 3, "y": 1}],
+//	  "walls": [{"x": 2, "y": 2}],
+//	  "snakes": [
+//	    {"body": [{"x": 1, "y": 3}, {"x": 1, "y": 4}]},
+//	    {"id": "guard", "name": "Guard", "body": [{"x": 4, "y": 4}], "moves": ["up", "down"]}
+//	  ]
+//	}
+//
+// Snakes without moves are played by the snakes in the create request, in
+// order. Snakes with moves are scripted opponents that make their moves in turn,
+// starting again from the first move when they run out.
+type Scenario struct {
+	Name   string          `json:"name"`
+	Width  int32           `json:"width"`
+	Height int32           `json:"height"`
+	Goal   ScenarioGoal    `json:"goal"`
+	Food   []Coords        `json:"food"`
+	Walls  []Coords        `json:"walls"`
+	Snakes []ScenarioSnake `json:"snakes"`
+}
+
+// ScenarioGoal is what the snakes have to do to complete a scenario.
+type ScenarioGoal struct {
+	Type  string `json:"type"`
+	Turns int32  `json:"turns"`
+}
+
+// ScenarioSnake is a snake in a scenario, the health defaults to the game's
+// starting health.
+type ScenarioSnake struct {
+	ID     string   `json:"id"`
+	Name   string   `json:"name"`
+	Health int32    `json:"health"`
+	Body   []Coords `json:"body"`
+	Moves  []string `json:"moves"`
+}
+
+var (
+	scenarios     = map[string]*Scenario{}
+	scenarioMutex = &sync.RWMutex{}
+)
+
+// RegisterScenario validates a scenario and makes it available under its name,
+// registering a name twice replaces the previous scenario.
+func RegisterScenario(s *Scenario) error {
+	if err := s.validate(); err != nil {
+		return err
+	}
+
+	scenarioMutex.Lock()
+	defer scenarioMutex.Unlock()
+
+	scenarios[s.Name] = s
+	return nil
+}
+
+// GetScenario returns the scenario registered under the given name.
+func GetScenario(name string) (*Scenario, error) {
+	scenarioMutex.RLock()
+	defer scenarioMutex.RUnlock()
+
+	s, ok := scenarios[name]
+	if !ok {
+		return nil, fmt.Errorf("rules: unknown scenario %q", name)
+	}
+	return s, nil
+}
+
+// LoadScenario reads a scenario from a JSON file.
+func LoadScenario(path string) (*Scenario, error) {
+	data, err := ioutil.ReadFile(path) // nolint: gosec
+	if err != nil {
+		return nil, err
+	}
+	s := &Scenario{}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("rules: invalid scenario %s: %v", path, err)
+	}
+	return s, nil
+}
+
+// LoadScenarios registers every scenario in the .json files of a directory.
+func LoadScenarios(dir string) error {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		s, err := LoadScenario(path)
+		if err != nil {
+			return err
+		}
+		if err := RegisterScenario(s); err != nil {
+			return fmt.Errorf("rules: invalid scenario %s: %v", path, err)
+		}
+	}
+	return nil
+}
+
+func (s *Scenario) validate() error {
+	if s.Name == "" {
+		return errors.New("scenario must have a name")
+	}
+	if s.Width <= 0 || s.Height <= 0 {
+		return errors.New("scenario must have a width and height")
+	}
+	switch s.Goal.Type {
+	case ScenarioGoalEatAllFood:
+	case ScenarioGoalSurvive:
+		if s.Goal.Turns <= 0 {
+			return errors.New("the survive goal needs a number of turns")
+		}
+	default:
+		return fmt.Errorf("unknown scenario goal %q", s.Goal.Type)
+	}
+	if s.Goal.Turns < 0 {
+		return errors.New("scenario goal turns must not be negative")
+	}
+	if len(s.players()) == 0 {
+		return errors.New("scenario must have a snake without moves to play")
+	}
+
+	points := append(coordsToPoints(s.Food), coordsToPoints(s.Walls)...)
+	for _, snake := range s.Snakes {
+		if len(snake.Body) == 0 {
+			return errors.New("scenario snakes must have a body")
+		}
+		if snake.Health < 0 {
+			return errors.New("scenario snake health must not be negative")
+		}
+		for _, move := range snake.Moves {
+			switch move {
+			case "up", "down", "left", "right":
+			default:
+				return fmt.Errorf("unknown scenario move %q", move)
+			}
+		}
+		points = append(points, coordsToPoints(snake.Body)...)
+	}
+	for _, p := range points {
+		if p.X < 0 || p.X >= s.Width || p.Y < 0 || p.Y >= s.Height {
+			return fmt.Errorf("scenario point %d,%d is outside the board", p.X, p.Y)
+		}
+	}
+	return nil
+}
+
+// players returns the snakes in the scenario that are played by snake servers.
+func (s *Scenario) players() []ScenarioSnake {
+	players := []ScenarioSnake{}
+	for _, snake := range s.Snakes {
+		if len(snake.Moves) == 0 {
+			players = append(players, snake)
+		}
+	}
+	return players
+}
+
+// opponents returns the scripted snakes in the scenario.
+func (s *Scenario) opponents() []ScenarioSnake {
+	opponents := []ScenarioSnake{}
+	for _, snake := range s.Snakes {
+		if len(snake.Moves) > 0 {
+			opponents = append(opponents, snake)
+		}
+	}
+	return opponents
+}
+
+// ScenarioRuleset plays the standard game from an authored scenario, the game
+// ends when the scenario's goal is completed or can no longer be completed. No
+// food spawns beyond the scenario's own.
+type ScenarioRuleset struct {
+	StandardRuleset
+}
+
+// CreateInitialGame creates a game from the scenario named in the request, the
+// snakes in the request play the scenario's snakes that have no moves. The
+// first frame is laid out exactly as the scenario is authored.
+func (ScenarioRuleset) CreateInitialGame(req *pb.CreateRequest) (*pb.Game, []*pb.GameFrame, error) {
+	scenario, err := GetScenario(req.Scenario)
+	if err != nil {
+		return nil, nil, err
+	}
+	players, opponents := scenario.players(), scenario.opponents()
+	if len(req.Snakes) != len(players) {
+		return nil, nil, fmt.Errorf("scenario %q is played by %d snakes", scenario.Name, len(players))
+	}
+
+	scenarioReq := *req
+	scenarioReq.Width = scenario.Width
+	scenarioReq.Height = scenario.Height
+	scenarioReq.Food = 0
+	scenarioReq.Map = ""
+	scenarioReq.MapGenerator = ""
+	scenarioReq.Snakes = append([]*pb.SnakeOptions{}, req.Snakes...)
+	for _, o := range opponents {
+		scenarioReq.Snakes = append(scenarioReq.Snakes, &pb.SnakeOptions{ID: o.ID, Name: o.Name})
+	}
+
+	game, rng, err := newGame(&scenarioReq)
+	if err != nil {
+		return nil, nil, err
+	}
+	game.FoodSpawner = FoodSpawnerNone
+	game.Scenario = scenario.Name
+	game.Goal = scenario.Goal.Type
+	game.GoalTurns = scenario.Goal.Turns
+	game.Scripts = map[string]*pb.Script{}
+
+	snakes := []*pb.Snake{}
+	for i, snake := range append(players, opponents...) {
+		s := newSnake(game, scenarioReq.Snakes[i], coordsToPoints(snake.Body), rng)
+		if snake.Health > 0 {
+			s.Health = snake.Health
+		}
+		if len(snake.Moves) > 0 {
+			game.Scripts[s.ID] = &pb.Script{Moves: snake.Moves}
+		}
+		if snakes, err = appendSnake(snakes, s); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	frames := []*pb.GameFrame{
+		{
+			Turn:   0,
+			Food:   coordsToPoints(scenario.Food),
+			Snakes: snakes,
+			Walls:  coordsToPoints(scenario.Walls),
+		},
+	}
+	return game, frames, nil
+}
+
+// CheckForGameOver checks if the scenario's goal has been completed or failed.
+func (ScenarioRuleset) CheckForGameOver(game *pb.Game, frame *pb.GameFrame) bool {
+	return scenarioGoalCompleted(game, frame) || scenarioGoalFailed(game, frame)
+}
+
+// scriptedMoves returns the moves a scripted snake makes in turn, or nil when
+// the snake is played by a snake server.
+func scriptedMoves(game *pb.Game, snake *pb.Snake) []string {
+	if script, ok := game.Scripts[snake.ID]; ok && script != nil {
+		return script.Moves
+	}
+	return nil
+}
+
+// alivePlayers returns the live snakes that are played by snake servers.
+func alivePlayers(game *pb.Game, frame *pb.GameFrame) []*pb.Snake {
+	players := []*pb.Snake{}
+	for _, s := range frame.AliveSnakes() {
+		if len(scriptedMoves(game, s)) == 0 {
+			players = append(players, s)
+		}
+	}
+	return players
+}
+
+func scenarioGoalCompleted(game *pb.Game, frame *pb.GameFrame) bool {
+	if len(alivePlayers(game, frame)) == 0 {
+		return false
+	}
+	switch game.Goal {
+	case ScenarioGoalEatAllFood:
+		return len(frame.Food) == 0 && (game.GoalTurns == 0 || frame.Turn <= game.GoalTurns)
+	case ScenarioGoalSurvive:
+		return frame.Turn >= game.GoalTurns
+	}
+	return false
+}
+
+func scenarioGoalFailed(game *pb.Game, frame *pb.GameFrame) bool {
+	if scenarioGoalCompleted(game, frame) {
+		return false
+	}
+	return len(alivePlayers(game, frame)) == 0 ||
+		(game.GoalTurns > 0 && frame.Turn >= game.GoalTurns) ||
+		maxTurnsReached(game, frame)
+}
+
+// scenarioResult is the result of a scenario, the snakes still playing win when
+// the goal is completed and nobody wins when it failed.
+func scenarioResult(game *pb.Game, frame *pb.GameFrame) *pb.GameResult {
+	if !scenarioGoalCompleted(game, frame) {
+		return &pb.GameResult{Reason: ResultReasonGoalFailed}
+	}
+	result := &pb.GameResult{Reason: ResultReasonGoalCompleted}
+	for _, s := range alivePlayers(game, frame) {
+		result.Winners = append(result.Winners, s.ID)
+	}
+	return result
+}
+
+// scriptedUpdate returns the move a scripted snake makes on the given turn.
+func scriptedUpdate(game *pb.Game, snake *pb.Snake, turn int32) *SnakeUpdate {
+	moves := scriptedMoves(game, snake)
+	return &SnakeUpdate{
+		Snake: snake,
+		Move:  moves[int(turn-1)%len(moves)],
+	}
+}
@@ -0,0 +1,191 @@
+package rules
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func testScenario() *Scenario {
+	return &Scenario{
+		Name:   "test-scenario",
+		Width:  5,
+		Height: 5,
+		Goal:   ScenarioGoal{Type: ScenarioGoalEatAllFood, Turns: 4},
+		Food:   []Coords{{X: 1, Y: 1}},
+		Walls:  []Coords{{X: 3, Y: 2}},
+		Snakes: []ScenarioSnake{
+			{Body: []Coords{{X: 1, Y: 3}, {X: 1, Y: 4}}},
+			{ID: "guard", Name: "Guard", Health: 50, Body: []Coords{{X: 4, Y: 3}, {X: 4, Y: 4}}, Moves: []string{"up", "down"}},
+		},
+	}
+}
+
+func TestRegisterScenarioInvalid(t *testing.T) {
+	noGoal := testScenario()
+	noGoal.Goal = ScenarioGoal{}
+	survive := testScenario()
+	survive.Goal = ScenarioGoal{Type: ScenarioGoalSurvive}
+	noPlayers := testScenario()
+	noPlayers.Snakes = noPlayers.Snakes[1:]
+	badMove := testScenario()
+	badMove.Snakes[1].Moves = []string{"jump"}
+	offBoard := testScenario()
+	offBoard.Food = []Coords{{X: 5, Y: 0}}
+	noBody := testScenario()
+	noBody.Snakes[0].Body = nil
+
+	for _, s := range []*Scenario{{}, noGoal, survive, noPlayers, badMove, offBoard, noBody} {
+		require.Error(t, RegisterScenario(s))
+	}
+}
+
+func TestLoadScenarios(t *testing.T) {
+	dir, err := ioutil.TempDir("", "scenarios")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir) // nolint: errcheck
+
+	data := `{
+		"name": "loaded-scenario",
+		"width": 5,
+		"height": 5,
+		"goal": {"type": "survive", "turns": 10},
+		"snakes": [{"body": [{"x": 2, "y": 2}]}]
+	}`
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "loaded.json"), []byte(data), 0600))
+
+	require.NoError(t, LoadScenarios(dir))
+	s, err := GetScenario("loaded-scenario")
+	require.NoError(t, err)
+	require.Equal(t, ScenarioGoal{Type: ScenarioGoalSurvive, Turns: 10}, s.Goal)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "broken.json"), []byte("{"), 0600))
+	require.Error(t, LoadScenarios(dir))
+}
+
+func TestCreateInitialGameScenario(t *testing.T) {
+	require.NoError(t, RegisterScenario(testScenario()))
+
+	g, frames, err := CreateInitialGame(&pb.CreateRequest{
+		Ruleset:  RulesetScenario,
+		Scenario: "test-scenario",
+		Width:    20,
+		Height:   20,
+		Food:     10,
+		Snakes:   []*pb.SnakeOptions{{ID: "player", Name: "Player"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(5), g.Width)
+	require.Equal(t, "test-scenario", g.Scenario)
+	require.Equal(t, ScenarioGoalEatAllFood, g.Goal)
+	require.Equal(t, int32(4), g.GoalTurns)
+	require.Equal(t, FoodSpawnerNone, g.FoodSpawner)
+
+	frame := frames[0]
+	require.Equal(t, []*pb.Point{{X: 1, Y: 1}}, frame.Food)
+	require.Equal(t, []*pb.Point{{X: 3, Y: 2}}, frame.Walls)
+	require.Len(t, frame.Snakes, 2)
+	require.Equal(t, "player", frame.Snakes[0].ID)
+	require.Equal(t, []*pb.Point{{X: 1, Y: 3}, {X: 1, Y: 4}}, frame.Snakes[0].Body)
+	require.Equal(t, int32(100), frame.Snakes[0].Health)
+	require.Equal(t, "guard", frame.Snakes[1].ID)
+	require.Equal(t, int32(50), frame.Snakes[1].Health)
+	require.Equal(t, map[string]*pb.Script{"guard": {Moves: []string{"up", "down"}}}, g.Scripts)
+
+	_, _, err = CreateInitialGame(&pb.CreateRequest{Ruleset: RulesetScenario, Scenario: "test-scenario"})
+	require.Error(t, err)
+	_, _, err = CreateInitialGame(&pb.CreateRequest{
+		Ruleset:  RulesetScenario,
+		Scenario: "not-a-scenario",
+		Snakes:   []*pb.SnakeOptions{{ID: "player"}},
+	})
+	require.Error(t, err)
+}
+
+func TestScenarioPlaysToCompletion(t *testing.T) {
+	require.NoError(t, RegisterScenario(testScenario()))
+	game, frames, err := CreateInitialGame(&pb.CreateRequest{
+		Ruleset:  RulesetScenario,
+		Scenario: "test-scenario",
+		Snakes:   []*pb.SnakeOptions{{ID: "player"}},
+	})
+	require.NoError(t, err)
+	ruleset, err := GetRuleset(game.Ruleset)
+	require.NoError(t, err)
+
+	frame := frames[0]
+	require.False(t, ruleset.CheckForGameOver(game, frame))
+
+	// the player has no snake server so keeps heading up, the guard follows its script
+	frame, err = ruleset.GameTick(game, frame)
+	require.NoError(t, err)
+	require.True(t, frame.Snakes[1].Head().Equal(&pb.Point{X: 4, Y: 2}))
+	require.False(t, ruleset.CheckForGameOver(game, frame))
+
+	frame, err = ruleset.GameTick(game, frame)
+	require.NoError(t, err)
+	require.True(t, frame.Snakes[1].Head().Equal(&pb.Point{X: 4, Y: 3}))
+	require.Empty(t, frame.Food)
+	require.True(t, ruleset.CheckForGameOver(game, frame))
+
+	result := GetGameResult(game, frame)
+	require.Equal(t, &pb.GameResult{Winners: []string{"player"}, Reason: ResultReasonGoalCompleted}, result)
+}
+
+func TestScenarioGoalFailed(t *testing.T) {
+	ruleset := ScenarioRuleset{}
+	player := &pb.Snake{ID: "player", Body: []*pb.Point{{X: 1, Y: 1}}}
+	guard := &pb.Snake{ID: "guard", Body: []*pb.Point{{X: 3, Y: 3}}}
+	scripts := map[string]*pb.Script{"guard": {Moves: []string{"up"}}}
+
+	game := &pb.Game{Ruleset: RulesetScenario, Goal: ScenarioGoalEatAllFood, GoalTurns: 4, Scripts: scripts}
+	frame := &pb.GameFrame{Turn: 4, Food: []*pb.Point{{X: 2, Y: 2}}, Snakes: []*pb.Snake{player, guard}}
+	require.True(t, ruleset.CheckForGameOver(game, frame))
+	require.Equal(t, &pb.GameResult{Reason: ResultReasonGoalFailed}, GetGameResult(game, frame))
+
+	game = &pb.Game{Ruleset: RulesetScenario, Goal: ScenarioGoalSurvive, GoalTurns: 10, Scripts: scripts}
+	frame = &pb.GameFrame{Turn: 3, Snakes: []*pb.Snake{player, guard}}
+	require.False(t, ruleset.CheckForGameOver(game, frame))
+
+	player.Death = &pb.Death{Cause: DeathCauseSnakeCollision, Turn: 3}
+	require.True(t, ruleset.CheckForGameOver(game, frame))
+	require.Equal(t, ResultReasonGoalFailed, GetGameResult(game, frame).Reason)
+
+	player.Death = nil
+	frame.Turn = 10
+	require.True(t, ruleset.CheckForGameOver(game, frame))
+	require.Equal(t, ResultReasonGoalCompleted, GetGameResult(game, frame).Reason)
+}
+
+func TestCreateInitialGameSmallScenario(t *testing.T) {
+	// every cell of the board is taken by a snake, there's no room for random
+	// start positions but the authored ones are fine
+	require.NoError(t, RegisterScenario(&Scenario{
+		Name:   "crowded",
+		Width:  2,
+		Height: 2,
+		Goal:   ScenarioGoal{Type: ScenarioGoalSurvive, Turns: 1},
+		Snakes: []ScenarioSnake{
+			{Body: []Coords{{X: 0, Y: 0}}},
+			{Body: []Coords{{X: 1, Y: 0}}, Moves: []string{"down"}},
+			{Body: []Coords{{X: 0, Y: 1}, {X: 1, Y: 1}}, Moves: []string{"up"}},
+		},
+	}))
+
+	g, frames, err := CreateInitialGame(&pb.CreateRequest{
+		Ruleset:  RulesetScenario,
+		Scenario: "crowded",
+		Snakes:   []*pb.SnakeOptions{{ID: "player"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, frames[0].Snakes, 3)
+	require.Equal(t, []*pb.Point{{X: 0, Y: 1}, {X: 1, Y: 1}}, frames[0].Snakes[2].Body)
+	require.Len(t, g.Scripts, 2)
+	for _, s := range frames[0].Snakes[1:] {
+		require.Contains(t, g.Scripts, s.ID)
+	}
+}
@@ -0,0 +1,100 @@
+package rules
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+)
+
+const (
+	// MoveModeSimultaneous asks every snake for its move at the same time and
+	// moves them all at once
+	MoveModeSimultaneous = "simultaneous"
+	// MoveModeSequential moves the snakes one at a time, each snake is asked for
+	// its move with the board as it is after the snakes before it have moved
+	MoveModeSequential = "sequential"
+)
+
+// sequentialTurnBudget is the longest the move requests of a sequential turn
+// can take altogether. Workers only keep a game locked for a few seconds
+// between frames, so a turn must not take longer than that however many
+// snakes there are.
+var sequentialTurnBudget = 4 * time.Second
+
+// validateMoveMode checks the move mode in a create request.
+func validateMoveMode(req *pb.CreateRequest) error {
+	switch req.MoveMode {
+	case "", MoveModeSimultaneous, MoveModeSequential:
+		return nil
+	}
+	return fmt.Errorf("rules: unknown move mode %q", req.MoveMode)
+}
+
+// moveMode returns the move mode of a game, games created before move modes
+// existed move simultaneously.
+func moveMode(game *pb.Game) string {
+	if game.MoveMode == "" {
+		return MoveModeSimultaneous
+	}
+	return game.MoveMode
+}
+
+// sequentialMoveOrder returns the alive snakes in the order they move on a
+// turn. The order rotates every turn so no snake always gets to move first.
+func sequentialMoveOrder(frame *pb.GameFrame, turn int32) []*pb.Snake {
+	snakes := frame.AliveSnakes()
+	if len(snakes) == 0 {
+		return snakes
+	}
+	first := int(turn-1) % len(snakes)
+	if first < 0 {
+		first += len(snakes)
+	}
+	return append(snakes[first:], snakes[:first]...)
+}
+
+// moveSnakesSequentially asks each snake for its move in turn and moves it
+// before asking the next snake, recording the order the snakes moved in on the
+// next frame. Tails only move once every snake has moved, when snakes eat.
+// Each snake gets at most an equal share of what is left of the turn budget.
+func moveSnakesSequentially(timeout time.Duration, game *pb.Game, lastFrame, nextFrame *pb.GameFrame) {
+	nextFrame.MoveOrder = []string{}
+	deadline := time.Now().Add(sequentialTurnBudget)
+	order := sequentialMoveOrder(lastFrame, nextFrame.Turn)
+	for i, snake := range order {
+		if len(scriptedMoves(game, snake)) > 0 {
+			updateSnakes(game, nextFrame, []*SnakeUpdate{scriptedUpdate(game, snake, nextFrame.Turn)})
+			nextFrame.MoveOrder = append(nextFrame.MoveOrder, snake.ID)
+			continue
+		}
+		share := time.Until(deadline) / time.Duration(len(order)-i)
+		if share < time.Millisecond {
+			share = time.Millisecond
+		}
+		responses := gatherSnakeResponses(multiSnakeRequest{
+			url:        "move",
+			timeout:    timeout,
+			maxTimeout: share,
+			game:       game,
+			frame:      lastFrame,
+		}, []*pb.Snake{snake})
+		for _, resp := range responses {
+			updateSnakes(game, nextFrame, []*SnakeUpdate{toSnakeUpdate(resp)})
+		}
+		nextFrame.MoveOrder = append(nextFrame.MoveOrder, snake.ID)
+	}
+}
+
+// movedBefore checks if a snake moved before the other snake this turn.
+func movedBefore(frame *pb.GameFrame, snake, other *pb.Snake) bool {
+	for _, id := range frame.MoveOrder {
+		switch id {
+		case snake.ID:
+			return true
+		case other.ID:
+			return false
+		}
+	}
+	return false
+}
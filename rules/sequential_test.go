@@ -0,0 +1,143 @@
+package rules
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSequentialMoveOrderRotates(t *testing.T) {
+	frame := &pb.GameFrame{
+		Snakes: []*pb.Snake{
+			{ID: "a"},
+			{ID: "b", Death: &pb.Death{Cause: DeathCauseStarvation}},
+			{ID: "c"},
+			{ID: "d"},
+		},
+	}
+	ids := func(snakes []*pb.Snake) []string {
+		ret := []string{}
+		for _, s := range snakes {
+			ret = append(ret, s.ID)
+		}
+		return ret
+	}
+	require.Equal(t, []string{"a", "c", "d"}, ids(sequentialMoveOrder(frame, 1)))
+	require.Equal(t, []string{"c", "d", "a"}, ids(sequentialMoveOrder(frame, 2)))
+	require.Equal(t, []string{"d", "a", "c"}, ids(sequentialMoveOrder(frame, 3)))
+	require.Equal(t, []string{"a", "c", "d"}, ids(sequentialMoveOrder(frame, 4)))
+}
+
+func TestSequentialHeadCollisionKillsLaterMover(t *testing.T) {
+	long := &pb.Snake{
+		ID:     "long",
+		Health: 50,
+		Body:   []*pb.Point{{X: 5, Y: 5}, {X: 5, Y: 6}, {X: 5, Y: 7}, {X: 5, Y: 8}},
+	}
+	short := &pb.Snake{
+		ID:     "short",
+		Health: 50,
+		Body:   []*pb.Point{{X: 5, Y: 5}, {X: 4, Y: 5}},
+	}
+	frame := &pb.GameFrame{
+		Turn:      2,
+		Snakes:    []*pb.Snake{long, short},
+		MoveOrder: []string{"short", "long"},
+	}
+	updates := checkForDeath(&pb.Game{Width: 10, Height: 10}, frame, ruleOptions{})
+	require.Len(t, updates, 1)
+	require.Equal(t, "long", updates[0].Snake.ID)
+	require.Equal(t, DeathCauseHeadToHeadCollision, updates[0].Death.Cause)
+}
+
+func TestGameTickSequentialMoves(t *testing.T) {
+	createClient = getNetClient
+
+	var mutex sync.Mutex
+	seen := map[string][]Coords{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := SnakeRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		heads := []Coords{}
+		for _, s := range req.Board.Snakes {
+			heads = append(heads, s.Body[0])
+		}
+		mutex.Lock()
+		seen[req.You.ID] = heads
+		mutex.Unlock()
+		_, err := w.Write([]byte(`{"move":"up"}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	game := &pb.Game{Width: 10, Height: 10, MoveMode: MoveModeSequential, SnakeTimeout: 1000}
+	next, err := GameTick(game, &pb.GameFrame{
+		Turn: 1,
+		Snakes: []*pb.Snake{
+			{ID: "a", URL: server.URL, Health: 50, Body: []*pb.Point{{X: 2, Y: 5}, {X: 2, Y: 6}}},
+			{ID: "b", URL: server.URL, Health: 50, Body: []*pb.Point{{X: 6, Y: 5}, {X: 6, Y: 6}}},
+		},
+	})
+	require.NoError(t, err)
+	// turn 2 starts with the second snake
+	require.Equal(t, []string{"b", "a"}, next.MoveOrder)
+	require.Equal(t, []Coords{{X: 2, Y: 5}, {X: 6, Y: 5}}, seen["b"])
+	require.Equal(t, []Coords{{X: 2, Y: 5}, {X: 6, Y: 4}}, seen["a"])
+	require.True(t, next.Snakes[0].Head().Equal(&pb.Point{X: 2, Y: 4}))
+	require.True(t, next.Snakes[1].Head().Equal(&pb.Point{X: 6, Y: 4}))
+}
+
+func TestGameTickSequentialTurnBudget(t *testing.T) {
+	createClient = getNetClient
+	budget := sequentialTurnBudget
+	sequentialTurnBudget = 300 * time.Millisecond
+	defer func() { sequentialTurnBudget = budget }()
+
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(time.Second)
+		_, err := w.Write([]byte(`{"move":"up"}`))
+		require.NoError(t, err)
+	}))
+	defer slow.Close()
+
+	game := &pb.Game{Width: 10, Height: 10, MoveMode: MoveModeSequential, SnakeTimeout: 2000}
+	start := time.Now()
+	_, err := GameTick(game, &pb.GameFrame{
+		Turn: 1,
+		Snakes: []*pb.Snake{
+			{ID: "a", URL: slow.URL, Health: 50, Body: []*pb.Point{{X: 2, Y: 5}, {X: 2, Y: 6}}},
+			{ID: "b", URL: slow.URL, Health: 50, Body: []*pb.Point{{X: 5, Y: 5}, {X: 5, Y: 6}}},
+			{ID: "c", URL: slow.URL, Health: 50, Body: []*pb.Point{{X: 8, Y: 5}, {X: 8, Y: 6}}},
+		},
+	})
+	require.NoError(t, err)
+	// the snakes share the turn budget instead of each waiting SnakeTimeout
+	require.True(t, time.Since(start) < time.Second, "turn took %v", time.Since(start))
+}
+
+func TestTimeoutForMaxTimeout(t *testing.T) {
+	mr := multiSnakeRequest{url: "move", timeout: 500 * time.Millisecond, maxTimeout: 100 * time.Millisecond}
+	require.Equal(t, 100*time.Millisecond, mr.timeoutFor(&pb.Snake{}))
+
+	mr.maxTimeout = time.Second
+	require.Equal(t, 500*time.Millisecond, mr.timeoutFor(&pb.Snake{}))
+}
+
+func TestCreateInitialGameMoveMode(t *testing.T) {
+	g, _, err := CreateInitialGame(&pb.CreateRequest{Width: 10, Height: 10})
+	require.NoError(t, err)
+	require.Equal(t, MoveModeSimultaneous, g.MoveMode)
+
+	g, _, err = CreateInitialGame(&pb.CreateRequest{Width: 10, Height: 10, MoveMode: MoveModeSequential})
+	require.NoError(t, err)
+	require.Equal(t, MoveModeSequential, g.MoveMode)
+
+	_, _, err = CreateInitialGame(&pb.CreateRequest{MoveMode: "whenever"})
+	require.Error(t, err)
+}
@@ -0,0 +1,121 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+)
+
+// Defaults for the per-game settings, used when a create request leaves a
+// setting unset. Games created before the settings existed also fall back to
+// these.
+const (
+	defaultStartingHealth    = 100
+	defaultFoodHealth        = 100
+	defaultHealthLossPerTurn = 1
+	defaultStartingLength    = 3
+	defaultGrowthPerFood     = 1
+	defaultMinimumLength     = 1
+
+	// maxGrowthPerFood caps how many segments a snake can grow by from one
+	// food, so a game can't make snakes grow without bound.
+	maxGrowthPerFood = 100
+)
+
+// validateSettings checks the health, length, growth, turn limit, food, move
+// mode, vision, time bank, failure policy and end condition settings in a
+// create request.
+func validateSettings(req *pb.CreateRequest) error {
+	if req.StartingHealth < 0 {
+		return errors.New("starting health must not be negative")
+	}
+	if req.FoodHealth < 0 {
+		return errors.New("food health must not be negative")
+	}
+	if req.HealthLossPerTurn < 0 {
+		return errors.New("health loss per turn must not be negative")
+	}
+	if req.StartingLength < 0 {
+		return errors.New("starting length must not be negative")
+	}
+	if req.GrowthPerFood < 0 {
+		return errors.New("growth per food must not be negative")
+	}
+	if req.GrowthPerFood > maxGrowthPerFood {
+		return fmt.Errorf("growth per food must be at most %d", maxGrowthPerFood)
+	}
+	if req.HungryHealth < 0 {
+		return errors.New("hungry health must not be negative")
+	}
+	if req.MinimumLength < 0 {
+		return errors.New("minimum length must not be negative")
+	}
+	if req.MaxTurns < 0 {
+		return errors.New("max turns must not be negative")
+	}
+	if err := validateTiebreaker(req.Tiebreaker); err != nil {
+		return err
+	}
+	if err := validateFoodPlacement(req); err != nil {
+		return err
+	}
+	if err := validateFoodWeights(req); err != nil {
+		return err
+	}
+	if err := validateMoveMode(req); err != nil {
+		return err
+	}
+	if err := validateVision(req); err != nil {
+		return err
+	}
+	if err := validateTimeBank(req); err != nil {
+		return err
+	}
+	if err := validateFailurePolicy(req); err != nil {
+		return err
+	}
+	return validateEndCondition(req)
+}
+
+// validateStartingLength checks the requested starting length fits on the
+// game's board, it is checked once the board size is known.
+func validateStartingLength(req *pb.CreateRequest, game *pb.Game) error {
+	if int64(req.StartingLength) > int64(game.Width)*int64(game.Height) {
+		return fmt.Errorf("starting length %d does not fit on a %dx%d board", req.StartingLength, game.Width, game.Height)
+	}
+	return nil
+}
+
+// settingOrDefault returns the setting, or the default when it is unset. Unset
+// and 0 can't be told apart so a setting can't be set to 0.
+func settingOrDefault(value, defaultValue int32) int32 {
+	if value == 0 {
+		return defaultValue
+	}
+	return value
+}
+
+func startingHealth(game *pb.Game) int32 {
+	return settingOrDefault(game.StartingHealth, defaultStartingHealth)
+}
+
+func foodHealth(game *pb.Game) int32 {
+	return settingOrDefault(game.FoodHealth, defaultFoodHealth)
+}
+
+func healthLossPerTurn(game *pb.Game) int32 {
+	return settingOrDefault(game.HealthLossPerTurn, defaultHealthLossPerTurn)
+}
+
+func startingLength(game *pb.Game) int32 {
+	return settingOrDefault(game.StartingLength, defaultStartingLength)
+}
+
+func growthPerFood(game *pb.Game) int32 {
+	return settingOrDefault(game.GrowthPerFood, defaultGrowthPerFood)
+}
+
+func minimumLength(game *pb.Game) int32 {
+	return settingOrDefault(game.MinimumLength, defaultMinimumLength)
+}
@@ -0,0 +1,148 @@
+package rules
+
+import (
+	"math"
+	"testing"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateInitialGameDefaultSettings(t *testing.T) {
+	g, frames, err := CreateInitialGame(&pb.CreateRequest{
+		Width:  20,
+		Height: 20,
+		Snakes: []*pb.SnakeOptions{{ID: "snake_123"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(100), g.StartingHealth)
+	require.Equal(t, int32(100), g.FoodHealth)
+	require.Equal(t, int32(1), g.HealthLossPerTurn)
+	require.Equal(t, int32(3), g.StartingLength)
+	require.Equal(t, int32(100), frames[0].Snakes[0].Health)
+	require.Len(t, frames[0].Snakes[0].Body, 3)
+}
+
+func TestCreateInitialGameCustomSettings(t *testing.T) {
+	g, frames, err := CreateInitialGame(&pb.CreateRequest{
+		Width:             20,
+		Height:            20,
+		StartingHealth:    300,
+		FoodHealth:        200,
+		HealthLossPerTurn: 2,
+		StartingLength:    5,
+		Snakes:            []*pb.SnakeOptions{{ID: "snake_123"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(200), g.FoodHealth)
+	require.Equal(t, int32(2), g.HealthLossPerTurn)
+	require.Equal(t, int32(300), frames[0].Snakes[0].Health)
+	require.Len(t, frames[0].Snakes[0].Body, 5)
+}
+
+func TestCreateInitialGameInvalidSettings(t *testing.T) {
+	reqs := []*pb.CreateRequest{
+		{StartingHealth: -1},
+		{FoodHealth: -1},
+		{HealthLossPerTurn: -1},
+		{StartingLength: -1},
+		{GrowthPerFood: -1},
+		{HungryHealth: -1},
+		{MinimumLength: -1},
+		{GrowthPerFood: maxGrowthPerFood + 1},
+		{Width: 10, Height: 10, StartingLength: 101},
+		{Width: 10, Height: 10, StartingLength: math.MaxInt32},
+	}
+	for _, req := range reqs {
+		_, _, err := CreateInitialGame(req)
+		require.Error(t, err)
+	}
+}
+
+func TestSettingsDefaultForOlderGames(t *testing.T) {
+	game := &pb.Game{}
+	require.Equal(t, int32(defaultStartingHealth), startingHealth(game))
+	require.Equal(t, int32(defaultFoodHealth), foodHealth(game))
+	require.Equal(t, int32(defaultHealthLossPerTurn), healthLossPerTurn(game))
+	require.Equal(t, int32(defaultStartingLength), startingLength(game))
+	require.Equal(t, int32(defaultGrowthPerFood), growthPerFood(game))
+	require.Equal(t, int32(defaultMinimumLength), minimumLength(game))
+}
+
+func TestGameTickUsesHealthSettings(t *testing.T) {
+	game := &pb.Game{Width: 10, Height: 10, FoodHealth: 150, HealthLossPerTurn: 5}
+	hungry := &pb.Snake{
+		ID:     "hungry",
+		Health: 50,
+		Body:   []*pb.Point{{X: 2, Y: 2}, {X: 2, Y: 3}, {X: 2, Y: 4}},
+	}
+	eating := &pb.Snake{
+		ID:     "eating",
+		Health: 50,
+		Body:   []*pb.Point{{X: 6, Y: 2}, {X: 6, Y: 3}, {X: 6, Y: 4}},
+	}
+	_, err := GameTick(game, &pb.GameFrame{
+		Turn:   1,
+		Food:   []*pb.Point{{X: 6, Y: 1}},
+		Snakes: []*pb.Snake{hungry, eating},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(45), hungry.Health)
+	require.Equal(t, int32(150), eating.Health)
+}
+
+func TestGameTickGrowthPerFood(t *testing.T) {
+	game := &pb.Game{Width: 10, Height: 10, GrowthPerFood: 3}
+	snake := &pb.Snake{
+		ID:     "eating",
+		Health: 50,
+		Body:   []*pb.Point{{X: 6, Y: 2}, {X: 6, Y: 3}, {X: 6, Y: 4}},
+	}
+	next, err := GameTick(game, &pb.GameFrame{
+		Turn:   1,
+		Food:   []*pb.Point{{X: 6, Y: 1}},
+		Snakes: []*pb.Snake{snake},
+	})
+	require.NoError(t, err)
+	require.Nil(t, snake.Death)
+	require.Len(t, snake.Body, 6)
+	require.Equal(t, []*pb.Point{{X: 6, Y: 3}, {X: 6, Y: 3}, {X: 6, Y: 3}, {X: 6, Y: 3}}, snake.Body[2:])
+
+	// the snake keeps moving with its tail stacked and doesn't collide with itself
+	next, err = GameTick(game, next)
+	require.NoError(t, err)
+	require.Nil(t, snake.Death)
+	require.Len(t, snake.Body, 6)
+}
+
+func TestGameTickHungrySnakesShrink(t *testing.T) {
+	game := &pb.Game{Width: 10, Height: 10, HungryHealth: 20, MinimumLength: 3}
+	hungry := &pb.Snake{
+		ID:     "hungry",
+		Health: 21,
+		Body:   []*pb.Point{{X: 2, Y: 2}, {X: 2, Y: 3}, {X: 2, Y: 4}, {X: 2, Y: 5}},
+	}
+	full := &pb.Snake{
+		ID:     "full",
+		Health: 50,
+		Body:   []*pb.Point{{X: 6, Y: 2}, {X: 6, Y: 3}, {X: 6, Y: 4}, {X: 6, Y: 5}},
+	}
+	next, err := GameTick(game, &pb.GameFrame{
+		Turn:   1,
+		Snakes: []*pb.Snake{hungry, full},
+	})
+	require.NoError(t, err)
+	require.Len(t, hungry.Body, 3)
+	require.Len(t, full.Body, 4)
+
+	// hungry snakes never shrink below the minimum length
+	_, err = GameTick(game, next)
+	require.NoError(t, err)
+	require.Len(t, hungry.Body, 3)
+}
+
+func TestShrinkSnakeKeepsHead(t *testing.T) {
+	snake := &pb.Snake{Body: []*pb.Point{{X: 1, Y: 1}, {X: 1, Y: 2}}}
+	shrinkSnake(&pb.Game{}, snake, 5)
+	require.Equal(t, []*pb.Point{{X: 1, Y: 1}}, snake.Body)
+}
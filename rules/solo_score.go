@@ -0,0 +1,40 @@
+package rules
+
+import "github.com/battlesnakeio/engine/controller/pb"
+
+// soloScore returns the score of the snake in a single player game that has
+// ended on the given frame.
+func soloScore(game *pb.Game, frame *pb.GameFrame) *pb.SoloScore {
+	if len(frame.Snakes) != 1 {
+		return nil
+	}
+	snake := frame.Snakes[0]
+	turns := frame.Turn
+	if snake.Death != nil {
+		turns = snake.Death.Turn - 1
+	}
+	if turns < 0 {
+		turns = 0
+	}
+	return &pb.SoloScore{
+		GameID:    game.ID,
+		SnakeURL:  snake.URL,
+		Width:     game.Width,
+		Height:    game.Height,
+		Turns:     turns,
+		FoodEaten: snake.FoodEaten,
+		Length:    int32(len(snake.Body)),
+	}
+}
+
+// BetterSoloScore checks if a solo score beats another. Surviving longer is
+// best, then eating more food, then finishing longer.
+func BetterSoloScore(score, other *pb.SoloScore) bool {
+	if score.Turns != other.Turns {
+		return score.Turns > other.Turns
+	}
+	if score.FoodEaten != other.FoodEaten {
+		return score.FoodEaten > other.FoodEaten
+	}
+	return score.Length > other.Length
+}
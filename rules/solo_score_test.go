@@ -0,0 +1,73 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGameResultSoloScore(t *testing.T) {
+	game := &pb.Game{ID: "solo", Mode: string(GameModeSinglePlayer), Width: 11, Height: 7}
+	snake := &pb.Snake{
+		ID:        "snake",
+		URL:       "http://snake",
+		Body:      []*pb.Point{{X: 1, Y: 1}, {X: 1, Y: 2}, {X: 1, Y: 3}, {X: 1, Y: 4}},
+		FoodEaten: 2,
+		Death:     &pb.Death{Cause: DeathCauseWallCollision, Turn: 30},
+	}
+	result := GetGameResult(game, &pb.GameFrame{Turn: 30, Snakes: []*pb.Snake{snake}})
+	require.Equal(t, &pb.SoloScore{
+		GameID:    "solo",
+		SnakeURL:  "http://snake",
+		Width:     11,
+		Height:    7,
+		Turns:     29,
+		FoodEaten: 2,
+		Length:    4,
+	}, result.Score)
+
+	// A snake still alive when the turn limit is reached survived every turn.
+	snake.Death = nil
+	game.MaxTurns = 50
+	result = GetGameResult(game, &pb.GameFrame{Turn: 50, Snakes: []*pb.Snake{snake}})
+	require.Equal(t, int32(50), result.Score.Turns)
+}
+
+func TestGetGameResultNoSoloScoreInMultiPlayerGames(t *testing.T) {
+	result := GetGameResult(&pb.Game{Mode: string(GameModeMultiPlayer)}, &pb.GameFrame{Turn: 5, Snakes: resultSnakes()})
+	require.Nil(t, result.Score)
+}
+
+func TestBetterSoloScore(t *testing.T) {
+	score := &pb.SoloScore{Turns: 10, FoodEaten: 2, Length: 5}
+	require.True(t, BetterSoloScore(&pb.SoloScore{Turns: 11}, score))
+	require.True(t, BetterSoloScore(&pb.SoloScore{Turns: 10, FoodEaten: 3}, score))
+	require.True(t, BetterSoloScore(&pb.SoloScore{Turns: 10, FoodEaten: 2, Length: 6}, score))
+	require.False(t, BetterSoloScore(&pb.SoloScore{Turns: 10, FoodEaten: 2, Length: 5}, score))
+	require.False(t, BetterSoloScore(&pb.SoloScore{Turns: 9, FoodEaten: 20, Length: 50}, score))
+}
+
+func TestCheckForSnakesEatingCountsFoodEaten(t *testing.T) {
+	snake := &pb.Snake{Health: 50, Body: []*pb.Point{{X: 1, Y: 1}, {X: 1, Y: 2}}}
+	frame := &pb.GameFrame{
+		Food:      []*pb.Point{{X: 1, Y: 1}},
+		FoodItems: []*pb.FoodItem{{Point: &pb.Point{X: 1, Y: 1}, Type: FoodTypeGolden}},
+		Snakes:    []*pb.Snake{snake},
+	}
+	checkForSnakesEating(&pb.Game{}, frame, false)
+	require.Equal(t, int32(1), snake.FoodEaten)
+
+	checkForSnakesEating(&pb.Game{}, &pb.GameFrame{Snakes: []*pb.Snake{snake}}, false)
+	require.Equal(t, int32(1), snake.FoodEaten)
+
+	// poison and shrink food don't count
+	for _, foodType := range []string{FoodTypePoison, FoodTypeShrink} {
+		checkForSnakesEating(&pb.Game{}, &pb.GameFrame{
+			Food:      []*pb.Point{snake.Head()},
+			FoodItems: []*pb.FoodItem{{Point: snake.Head(), Type: foodType}},
+			Snakes:    []*pb.Snake{snake},
+		}, false)
+		require.Equal(t, int32(1), snake.FoodEaten, foodType)
+	}
+}
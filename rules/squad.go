@@ -0,0 +1,108 @@
+package rules
+
+import "github.com/battlesnakeio/engine/controller/pb"
+
+// RulesetSquad is the name of the squad ruleset
+const RulesetSquad = "squad"
+
+func init() {
+	RegisterRuleset(RulesetSquad, SquadRuleset{})
+}
+
+// SquadRuleset plays the standard game with snakes split into squads, the game
+// ends when at most one squad has snakes left alive. Snakes without a squad play
+// as a squad of their own. The squad settings on the game control how much a
+// squad plays as one snake.
+type SquadRuleset struct {
+	StandardRuleset
+}
+
+// CreateInitialGame creates a new squad game and records the squad settings
+// from the request on the game.
+func (r SquadRuleset) CreateInitialGame(req *pb.CreateRequest) (*pb.Game, []*pb.GameFrame, error) {
+	game, frames, err := r.StandardRuleset.CreateInitialGame(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	game.AllowBodyCollisions = req.AllowBodyCollisions
+	game.SharedElimination = req.SharedElimination
+	game.SharedHealth = req.SharedHealth
+	game.SharedLength = req.SharedLength
+	return game, frames, nil
+}
+
+// GameTick runs the standard game tick with the squad settings of the game.
+func (SquadRuleset) GameTick(game *pb.Game, lastFrame *pb.GameFrame) (*pb.GameFrame, error) {
+	return gameTick(game, lastFrame, ruleOptions{
+		allowSquadBodyCollisions: game.AllowBodyCollisions,
+		sharedElimination:        game.SharedElimination,
+		sharedHealth:             game.SharedHealth,
+		sharedLength:             game.SharedLength,
+	})
+}
+
+// CheckForGameOver checks if there are SurvivorCount or fewer squads with snakes
+// left alive. Games with other end conditions end as standard games do.
+func (r SquadRuleset) CheckForGameOver(game *pb.Game, frame *pb.GameFrame) bool {
+	if endConditionName(game) != EndConditionLastStanding {
+		return r.StandardRuleset.CheckForGameOver(game, frame)
+	}
+	if maxTurnsReached(game, frame) {
+		return true
+	}
+
+	squads := map[string]bool{}
+	for _, s := range frame.AliveSnakes() {
+		squads[squadOf(s)] = true
+	}
+	return int32(len(squads)) <= survivorCount(game)
+}
+
+func squadOf(s *pb.Snake) string {
+	if s.Squad == "" {
+		return s.ID
+	}
+	return s.Squad
+}
+
+func sameSquad(snake, other *pb.Snake) bool {
+	return squadOf(snake) == squadOf(other)
+}
+
+// shareSquadAttributes brings every live snake up to the health and length of
+// the healthiest and longest live snake in its squad.
+func shareSquadAttributes(frame *pb.GameFrame, health, length bool) {
+	alive := frame.AliveSnakes()
+	for _, s := range alive {
+		for _, other := range alive {
+			if !sameSquad(s, other) {
+				continue
+			}
+			if health && other.Health > s.Health {
+				s.Health = other.Health
+			}
+			if length {
+				for len(s.Body) > 0 && len(s.Body) < len(other.Body) {
+					tail := s.Tail()
+					s.Body = append(s.Body, &pb.Point{X: tail.X, Y: tail.Y})
+				}
+			}
+		}
+	}
+}
+
+// eliminateSquads kills the live snakes that have a dead squad-mate.
+func eliminateSquads(frame *pb.GameFrame) {
+	for _, s := range frame.AliveSnakes() {
+		for _, other := range frame.Snakes {
+			if other.Death != nil && sameSquad(s, other) {
+				s.Death = &pb.Death{
+					Turn:  frame.Turn,
+					Cause: DeathCauseSquadEliminated,
+				}
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSquadCreateInitialGame(t *testing.T) {
+	g, frames, err := CreateInitialGame(&pb.CreateRequest{
+		Width:               11,
+		Height:              11,
+		Ruleset:             RulesetSquad,
+		AllowBodyCollisions: true,
+		SharedHealth:        true,
+		Snakes: []*pb.SnakeOptions{
+			{Name: "one", Squad: "red"},
+			{Name: "two", Squad: "red"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, RulesetSquad, g.Ruleset)
+	require.True(t, g.AllowBodyCollisions)
+	require.True(t, g.SharedHealth)
+	require.False(t, g.SharedElimination)
+	require.False(t, g.SharedLength)
+	require.Equal(t, "red", frames[0].Snakes[0].Squad)
+	require.Equal(t, "red", frames[0].Snakes[1].Squad)
+}
+
+func TestSquadCheckForGameOver(t *testing.T) {
+	game := &pb.Game{Mode: string(GameModeMultiPlayer)}
+	frame := &pb.GameFrame{
+		Snakes: []*pb.Snake{
+			{ID: "1", Squad: "red"},
+			{ID: "2", Squad: "red"},
+			{ID: "3", Squad: "blue"},
+		},
+	}
+	ruleset := SquadRuleset{}
+	require.False(t, ruleset.CheckForGameOver(game, frame))
+
+	frame.Snakes[2].Death = &pb.Death{Cause: DeathCauseStarvation}
+	require.True(t, ruleset.CheckForGameOver(game, frame))
+}
+
+func TestSquadCheckForGameOverWithoutSquads(t *testing.T) {
+	game := &pb.Game{Mode: string(GameModeMultiPlayer)}
+	frame := &pb.GameFrame{
+		Snakes: []*pb.Snake{
+			{ID: "1"},
+			{ID: "2"},
+		},
+	}
+	require.False(t, SquadRuleset{}.CheckForGameOver(game, frame))
+}
+
+func TestSquadCheckForGameOverEndConditions(t *testing.T) {
+	frame := &pb.GameFrame{
+		Snakes: []*pb.Snake{
+			{ID: "1", Squad: "red"},
+			{ID: "2", Squad: "blue"},
+			{ID: "3", Squad: "green"},
+		},
+	}
+	frame.Snakes[2].Death = &pb.Death{Cause: DeathCauseStarvation}
+
+	game := &pb.Game{EndCondition: EndConditionLastStanding, SurvivorCount: 2}
+	require.True(t, SquadRuleset{}.CheckForGameOver(game, frame))
+
+	game = &pb.Game{Mode: string(GameModeSurvival), EndCondition: EndConditionAllDead}
+	require.False(t, SquadRuleset{}.CheckForGameOver(game, frame))
+}
+
+func TestShareSquadAttributes(t *testing.T) {
+	frame := &pb.GameFrame{
+		Snakes: []*pb.Snake{
+			{ID: "1", Squad: "red", Health: 40, Body: []*pb.Point{{X: 1, Y: 1}}},
+			{ID: "2", Squad: "red", Health: 90, Body: []*pb.Point{{X: 2, Y: 1}, {X: 2, Y: 2}, {X: 2, Y: 3}}},
+			{ID: "3", Squad: "blue", Health: 100, Body: []*pb.Point{{X: 3, Y: 1}, {X: 3, Y: 2}, {X: 3, Y: 3}, {X: 3, Y: 4}}},
+		},
+	}
+	shareSquadAttributes(frame, true, true)
+	require.Equal(t, int32(90), frame.Snakes[0].Health)
+	require.Equal(t, []*pb.Point{{X: 1, Y: 1}, {X: 1, Y: 1}, {X: 1, Y: 1}}, frame.Snakes[0].Body)
+	require.Equal(t, int32(90), frame.Snakes[1].Health)
+	require.Len(t, frame.Snakes[1].Body, 3)
+}
+
+func TestEliminateSquads(t *testing.T) {
+	frame := &pb.GameFrame{
+		Turn: 4,
+		Snakes: []*pb.Snake{
+			{ID: "1", Squad: "red", Death: &pb.Death{Cause: DeathCauseWallCollision, Turn: 4}},
+			{ID: "2", Squad: "red"},
+			{ID: "3", Squad: "blue"},
+		},
+	}
+	eliminateSquads(frame)
+	require.Equal(t, &pb.Death{Cause: DeathCauseSquadEliminated, Turn: 4}, frame.Snakes[1].Death)
+	require.Nil(t, frame.Snakes[2].Death)
+}
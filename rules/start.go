@@ -45,9 +45,9 @@ func isValidColour(colour string) bool {
 	return re.Match([]byte(colour))
 }
 
-func getEffectiveColor(meta SnakeMetadata) string {
+func getEffectiveColor(meta SnakeMetadata, defaultColor string) string {
 	if meta.Err != nil || meta.Snake == nil || !isValidColour(meta.Color) {
-		return nextColor()
+		return defaultColor
 	}
 	return meta.Color
 }
@@ -60,8 +60,9 @@ func notifyGameStart(game *pb.Game, startState *pb.GameFrame) {
 	timeout := 5 * time.Second
 	responses := gatherSnakeStartResponses(timeout, game, startState)
 
+	colors := pickColors(startState.Snakes, newTurnRand(game.Seed, 0))
 	for _, resp := range responses {
-		resp.Snake.Color = getEffectiveColor(resp)
+		resp.Snake.Color = getEffectiveColor(resp, colors[resp.Snake.ID])
 	}
 }
 
@@ -17,21 +17,21 @@ func TestStartSnakes(t *testing.T) {
 }
 
 func TestStartSnakesMissingColor(t *testing.T) {
-	resetPalette([]string{"red", "green", "blue"})
+	resetPalette([]string{"red"})
 	snake := getSnakeAfterStart(t, "{}", 200)
 	require.Equal(t, "red", snake.Color)
 	require.Nil(t, snake.Death, "Snake should not be dead")
 }
 
 func TestStartSnakesMissingEndpoint(t *testing.T) {
-	resetPalette([]string{"red", "green", "blue"})
+	resetPalette([]string{"red"})
 	snake := getSnakeAfterStart(t, "{}", 404)
 	require.Equal(t, "red", snake.Color)
 	require.Nil(t, snake.Death, "Snake should not be dead")
 }
 
 func TestStartSnakesMissingServer(t *testing.T) {
-	resetPalette([]string{"red", "green", "blue"})
+	resetPalette([]string{"red"})
 	snake := getSnakeAfterMissingServer(t)
 	require.Equal(t, "red", snake.Color)
 	require.Nil(t, snake.Death, "Snake should not be dead")
@@ -2,7 +2,6 @@ package rules
 
 import (
 	"fmt"
-	"math"
 	"math/rand"
 	"time"
 
@@ -10,44 +9,66 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-func init() {
-	rand.Seed(time.Now().UTC().UnixNano())
+// GameTick runs the game one tick using the ruleset the game was created with
+func GameTick(game *pb.Game, lastFrame *pb.GameFrame) (*pb.GameFrame, error) {
+	ruleset, err := GetRuleset(game.Ruleset)
+	if err != nil {
+		return nil, err
+	}
+	return ruleset.GameTick(game, lastFrame)
 }
 
 // GameTick runs the game one tick and updates the state
-func GameTick(game *pb.Game, lastFrame *pb.GameFrame) (*pb.GameFrame, error) {
+func (StandardRuleset) GameTick(game *pb.Game, lastFrame *pb.GameFrame) (*pb.GameFrame, error) {
+	return gameTick(game, lastFrame, ruleOptions{})
+}
+
+// gameTick runs the standard game tick with the given rule options applied
+func gameTick(game *pb.Game, lastFrame *pb.GameFrame, opts ruleOptions) (*pb.GameFrame, error) {
 	if lastFrame == nil {
 		return nil, fmt.Errorf("rules: invalid state, previous frame is nil")
 	}
 	nextFrame := &pb.GameFrame{
-		Turn:   lastFrame.Turn + 1,
-		Snakes: lastFrame.Snakes,
-		Food:   lastFrame.Food,
+		Turn:      lastFrame.Turn + 1,
+		Snakes:    lastFrame.Snakes,
+		Food:      lastFrame.Food,
+		Hazards:   lastFrame.Hazards,
+		Walls:     lastFrame.Walls,
+		FoodItems: lastFrame.FoodItems,
 	}
+	rng := newTurnRand(game.Seed, nextFrame.Turn)
 	duration := time.Duration(game.SnakeTimeout) * time.Millisecond
 	log.WithFields(log.Fields{
 		"GameID":  game.ID,
 		"Turn":    nextFrame.Turn,
 		"Timeout": duration,
 	}).Info("GatherSnakeMoves")
-	moves := GatherSnakeMoves(duration, game, lastFrame)
+	if game.MoveMode == MoveModeSequential {
+		moveSnakesSequentially(duration, game, lastFrame, nextFrame)
+	} else {
+		moves := GatherSnakeMoves(duration, game, lastFrame)
 
-	// we have all the snake moves now
-	// 1. update snake coords
-	updateSnakes(game, nextFrame, moves)
+		// we have all the snake moves now
+		// 1. update snake coords
+		updateSnakes(game, nextFrame, moves)
+	}
 	// 2. game update
 	//    a - turn incr -- done above when the next tick is created
-	//    b - reduce health points
+	//    b - reduce health points, snakes with their head in a hazard lose extra health
 	//    c - grow snakes, and update snake health if they ate
 	//    d - shrink snakes that didn't eat
 	//    e - remove eaten food
 	//    f - replace eaten food
+	//    g - share health and length within squads
 	log.WithFields(log.Fields{
 		"GameID": game.ID,
 		"Turn":   nextFrame.Turn,
 	}).Info("reduce snake health")
 	for _, s := range nextFrame.AliveSnakes() {
-		s.Health = s.Health - 1
+		s.Health = s.Health - healthLossPerTurn(game)
+		if isHazard(s.Head(), nextFrame.Hazards) {
+			s.Health = s.Health - game.HazardDamage
+		}
 	}
 
 	log.WithFields(log.Fields{
@@ -55,12 +76,13 @@ func GameTick(game *pb.Game, lastFrame *pb.GameFrame) (*pb.GameFrame, error) {
 		"Turn":   nextFrame.Turn,
 	}).Info("handle food")
 
-	foodToRemove := checkForSnakesEating(nextFrame)
-	nextFood, err := updateFood(game, lastFrame, foodToRemove)
-	if err != nil {
+	foodToRemove := checkForSnakesEating(game, nextFrame, opts.growEveryTurn)
+	if err := updateFood(game, lastFrame, nextFrame, foodToRemove, rng); err != nil {
 		return nil, err
 	}
-	nextFrame.Food = nextFood
+	if opts.sharedHealth || opts.sharedLength {
+		shareSquadAttributes(nextFrame, opts.sharedHealth, opts.sharedLength)
+	}
 
 	// 3. check for death
 	// 	  a - starvation
@@ -70,16 +92,22 @@ func GameTick(game *pb.Game, lastFrame *pb.GameFrame) (*pb.GameFrame, error) {
 		"GameID": game.ID,
 		"Turn":   nextFrame.Turn,
 	}).Info("check for death")
-	deathUpdates := checkForDeath(game.Width, game.Height, nextFrame)
+	deathUpdates := checkForDeath(game, nextFrame, opts)
 	for _, du := range deathUpdates {
 		if du.Snake.Death == nil {
 			du.Snake.Death = du.Death
 		}
 	}
+	if opts.sharedElimination {
+		eliminateSquads(nextFrame)
+	}
 	return nextFrame, nil
 }
 
-func updateFood(game *pb.Game, gameFrame *pb.GameFrame, foodToRemove []*pb.Point) ([]*pb.Point, error) {
+// updateFood removes eaten food and spawns new food with the game's food
+// spawner, setting the food, food items and turns since food last spawned on
+// the next frame.
+func updateFood(game *pb.Game, gameFrame, nextFrame *pb.GameFrame, foodToRemove []*pb.Point, rng *rand.Rand) error {
 	var food []*pb.Point
 	// discover what food was not eaten
 	for _, foodPos := range gameFrame.Food {
@@ -96,69 +124,64 @@ func updateFood(game *pb.Game, gameFrame *pb.GameFrame, foodToRemove []*pb.Point
 		}
 	}
 
-	foodToAdd := 0
-	if game.MaxTurnsToNextFoodSpawn <= 0 {
-		foodToAdd = len(foodToRemove)
-	} else {
-		if game.TurnsSinceLastFoodSpawn == game.MaxTurnsToNextFoodSpawn {
-			foodToAdd = int(math.Ceil(float64(len(gameFrame.AliveSnakes())) / 2.0))
-		} else {
-			chance := rand.Int31n(1001) // use 101 here so we get 0-100 inclusive
-			calculatedChance := calculateFoodSpawnChance(game)
-			log.WithFields(log.Fields{
-				"GameID":            game.ID,
-				"Food Spawn Chance": chance,
-				"Turns Since Last":  game.TurnsSinceLastFoodSpawn,
-				"Calculate Chance":  calculatedChance,
-			}).Info("food spawn chance")
-			fmt.Println(len(gameFrame.AliveSnakes()))
-			if float64(chance) <= calculatedChance {
-				foodToAdd = int(math.Ceil(float64(len(gameFrame.AliveSnakes())) / 2.0))
-
-			}
-		}
+	spawner, err := GetFoodSpawner(gameFoodSpawner(game))
+	if err != nil {
+		return err
 	}
-
-	if foodToAdd > 0 {
-		game.TurnsSinceLastFoodSpawn = 0
-		for i := 0; i < foodToAdd; i++ {
-			p := getUnoccupiedPoint(game.Width, game.Height, gameFrame.Food, gameFrame.AliveSnakes())
-			if p != nil {
-				food = append(food, p)
-			}
-		}
+	newFood := spawner.SpawnFood(game, &pb.GameFrame{
+		Turn:                    gameFrame.Turn,
+		Food:                    food,
+		Snakes:                  gameFrame.AliveSnakes(),
+		Walls:                   gameFrame.Walls,
+		TurnsSinceLastFoodSpawn: gameFrame.TurnsSinceLastFoodSpawn,
+	}, len(foodToRemove), rng)
+
+	turnsSinceLastFoodSpawn := gameFrame.TurnsSinceLastFoodSpawn
+	if len(newFood) > 0 {
+		turnsSinceLastFoodSpawn = 0
 	} else {
-		game.TurnsSinceLastFoodSpawn++
+		turnsSinceLastFoodSpawn++
 	}
 
-	return food, nil
+	nextFrame.Food = append(food, newFood...)
+	nextFrame.FoodItems = append(remainingFoodItems(gameFrame.FoodItems, food), typeFood(game, newFood, rng)...)
+	nextFrame.TurnsSinceLastFoodSpawn = turnsSinceLastFoodSpawn
+	return nil
 }
 
-func calculateFoodSpawnChance(game *pb.Game) float64 {
-	minSpawnChance := float64(0.5)
-
-	ratio := math.Pow(1000/minSpawnChance, 1.0/float64(game.MaxTurnsToNextFoodSpawn-1))
-	seqNum := float64(game.TurnsSinceLastFoodSpawn)
+// getFoodSpawnPoint picks a free point for new food with the game's food
+// placement. On a map with food spawn points food only spawns on those points.
+func getFoodSpawnPoint(game *pb.Game, walls, food []*pb.Point, snakes []*pb.Snake, rng *rand.Rand) *pb.Point {
+	occupied := append(append([]*pb.Point{}, walls...), food...)
+	if len(game.FoodSpawns) == 0 {
+		return pickFoodPoint(game, getUnoccupiedPoints(game.Width, game.Height, occupied, snakes), snakes, rng)
+	}
 
-	spawnChance := minSpawnChance * ((1 - math.Pow(ratio, seqNum)) / (1 - ratio))
-	return spawnChance
+	taken := getUniqOccupiedPoints(occupied, snakes)
+	openPoints := []*pb.Point{}
+	for _, p := range game.FoodSpawns {
+		if !containsPoint(taken, p) {
+			openPoints = append(openPoints, p.Clone())
+		}
+	}
+	return pickFoodPoint(game, openPoints, snakes, rng)
 }
 
-func getUnoccupiedPoint(width, height int32, food []*pb.Point, snakes []*pb.Snake) *pb.Point {
+func getUnoccupiedPoint(width, height int32, food []*pb.Point, snakes []*pb.Snake, rng *rand.Rand) *pb.Point {
 	openPoints := getUnoccupiedPoints(width, height, food, snakes)
-	return pickRandomPoint(openPoints)
+	return pickRandomPoint(openPoints, rng)
 }
 
-func getUnoccupiedPointOdd(width, height int32, food []*pb.Point, snakes []*pb.Snake) *pb.Point {
+func getUnoccupiedPointOdd(width, height int32, food []*pb.Point, snakes []*pb.Snake, rng *rand.Rand) *pb.Point {
 	openPoints := getUnoccupiedPoints(width, height, food, snakes)
 	openPoints = filterPoints(openPoints, true)
-	return pickRandomPoint(openPoints)
+	return pickRandomPoint(openPoints, rng)
 }
 
-func getUnoccupiedPointEven(width, height int32, food []*pb.Point, snakes []*pb.Snake) *pb.Point {
+func getUnoccupiedPointEven(width, height int32, food []*pb.Point, snakes []*pb.Snake, rng *rand.Rand) *pb.Point {
 	openPoints := getUnoccupiedPoints(width, height, food, snakes)
 	openPoints = filterPoints(openPoints, false)
-	return pickRandomPoint(openPoints)
+	return pickRandomPoint(openPoints, rng)
 }
 
 func filterPoints(openPoints []*pb.Point, even bool) []*pb.Point {
@@ -175,12 +198,12 @@ func filterPoints(openPoints []*pb.Point, even bool) []*pb.Point {
 	return filteredPoints
 }
 
-func pickRandomPoint(openPoints []*pb.Point) *pb.Point {
+func pickRandomPoint(openPoints []*pb.Point, rng *rand.Rand) *pb.Point {
 	if len(openPoints) == 0 {
 		return nil
 	}
 
-	randIndex := rand.Intn(len(openPoints))
+	randIndex := rng.Intn(len(openPoints))
 
 	return openPoints[randIndex]
 }
@@ -252,6 +275,11 @@ func getUniqOccupiedPoints(food []*pb.Point, snakes []*pb.Snake) []*pb.Point {
 func updateSnakes(game *pb.Game, frame *pb.GameFrame, moves []*SnakeUpdate) {
 	for _, update := range moves {
 		update.Snake.Latency = fmt.Sprint(int64(update.Latency) / 1e6)
+		if game.TimeBank > 0 {
+			spendTimeBank(game, update)
+		}
+		update.Snake.MoveResult = moveResult(update)
+		recordMoveFailure(update.Snake, moveFailure(update))
 		if update.Err != nil {
 			log.WithFields(log.Fields{
 				"GameID":  game.ID,
@@ -271,16 +299,36 @@ func updateSnakes(game *pb.Game, frame *pb.GameFrame, moves []*SnakeUpdate) {
 			}).Info("Move")
 			update.Snake.Move(update.Move)
 		}
+		if game.Wrapped {
+			update.Snake.WrapHead(game.Width, game.Height)
+		}
 	}
 }
 
-func checkForSnakesEating(frame *pb.GameFrame) []*pb.Point {
+// checkForSnakesEating moves each snake's tail and applies the food each snake
+// ate, growing every snake when growEveryTurn is set. Hungry snakes that didn't
+// eat lose a tail segment. It returns the food that was eaten.
+func checkForSnakesEating(game *pb.Game, frame *pb.GameFrame, growEveryTurn bool) []*pb.Point {
 	foodToRemove := []*pb.Point{}
 	for _, snake := range frame.AliveSnakes() {
 		ate := false
+		growth, shrink := int32(0), int32(0)
 		for _, foodPos := range frame.Food {
 			if snake.Head().Equal(foodPos) {
-				snake.Health = 100
+				switch foodType(frame, foodPos) {
+				case FoodTypeGolden:
+					snake.Health = foodHealth(game)
+					growth += growthPerFood(game) + goldenFoodExtraLength
+					snake.FoodEaten++
+				case FoodTypePoison:
+					snake.Health = snake.Health - poisonFoodDamage
+				case FoodTypeShrink:
+					shrink += shrinkFoodLength
+				default:
+					snake.Health = foodHealth(game)
+					growth += growthPerFood(game)
+					snake.FoodEaten++
+				}
 				ate = true
 				foodToRemove = append(foodToRemove, foodPos)
 				log.WithFields(log.Fields{
@@ -288,6 +336,7 @@ func checkForSnakesEating(frame *pb.GameFrame) []*pb.Point {
 					"Name":    snake.Name,
 					"Turn":    frame.Turn,
 					"Food":    foodPos,
+					"Type":    foodType(frame, foodPos),
 				}).Info("snake ate")
 			}
 		}
@@ -298,10 +347,35 @@ func checkForSnakesEating(frame *pb.GameFrame) []*pb.Point {
 		if len(snake.Body) != 0 {
 			snake.Body = snake.Body[:len(snake.Body)-1]
 		}
-		if ate {
+		if growEveryTurn {
+			snake.Health = foodHealth(game)
+			if growth == 0 {
+				growth = 1
+			}
+		}
+		if !ate && game.HungryHealth > 0 && snake.Health <= game.HungryHealth {
+			shrink++
+		}
+		shrinkSnake(game, snake, shrink)
+		for i := int32(0); i < growth; i++ {
 			tail := snake.Tail()
 			snake.Body = append(snake.Body, &pb.Point{X: tail.X, Y: tail.Y})
 		}
 	}
 	return foodToRemove
 }
+
+// shrinkSnake removes segments from the snake's tail, a snake never shrinks
+// below the game's minimum length and always keeps its head.
+func shrinkSnake(game *pb.Game, snake *pb.Snake, segments int32) {
+	length := int32(len(snake.Body)) - segments
+	if length < minimumLength(game) {
+		length = minimumLength(game)
+	}
+	if length < 1 {
+		length = 1
+	}
+	if length < int32(len(snake.Body)) {
+		snake.Body = snake.Body[:length]
+	}
+}
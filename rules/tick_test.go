@@ -2,7 +2,6 @@ package rules
 
 import (
 	"errors"
-	"math/rand"
 	"testing"
 
 	"github.com/battlesnakeio/engine/controller/pb"
@@ -10,7 +9,8 @@ import (
 )
 
 func TestUpdateFood(t *testing.T) {
-	updated, err := updateFood(&pb.Game{Width: 20, Height: 20}, &pb.GameFrame{
+	next := &pb.GameFrame{}
+	err := updateFood(&pb.Game{Width: 20, Height: 20}, &pb.GameFrame{
 		Food: []*pb.Point{
 			{X: 1, Y: 1},
 			{X: 1, Y: 2},
@@ -24,10 +24,11 @@ func TestUpdateFood(t *testing.T) {
 				},
 			},
 		},
-	}, []*pb.Point{
+	}, next, []*pb.Point{
 		{X: 1, Y: 2},
-	})
+	}, newTurnRand(1, 1))
 	require.NoError(t, err)
+	updated := next.Food
 	require.Len(t, updated, 2)
 	require.True(t, updated[0].Equal(&pb.Point{X: 1, Y: 1}))
 	require.False(t, updated[1].Equal(&pb.Point{X: 1, Y: 2}))
@@ -37,7 +38,8 @@ func TestUpdateFood(t *testing.T) {
 }
 
 func TestUpdateFoodWithFullBoard(t *testing.T) {
-	updated, err := updateFood(&pb.Game{Width: 2, Height: 2}, &pb.GameFrame{
+	next := &pb.GameFrame{}
+	err := updateFood(&pb.Game{Width: 2, Height: 2}, &pb.GameFrame{
 		Food: []*pb.Point{
 			{X: 0, Y: 0},
 		},
@@ -51,25 +53,27 @@ func TestUpdateFoodWithFullBoard(t *testing.T) {
 				},
 			},
 		},
-	}, []*pb.Point{
+	}, next, []*pb.Point{
 		{X: 0, Y: 0},
-	})
+	}, newTurnRand(1, 1))
 	require.NoError(t, err)
-	require.Len(t, updated, 0)
+	require.Len(t, next.Food, 0)
 }
 
 func TestGetUnoccupiedPointEven(t *testing.T) {
 
 	unoccupiedPoint := getUnoccupiedPointEven(2, 2,
 		[]*pb.Point{},
-		[]*pb.Snake{})
+		[]*pb.Snake{},
+		newTurnRand(1, 1))
 	require.True(t, (unoccupiedPoint.X+unoccupiedPoint.Y)%2 == 0, "Point coordinates should sum to an even number %o ", unoccupiedPoint)
 }
 
 func TestGetUnoccupiedPointOdd(t *testing.T) {
 	unoccupiedPoint := getUnoccupiedPointOdd(2, 2,
 		[]*pb.Point{{X: 0, Y: 1}},
-		[]*pb.Snake{})
+		[]*pb.Snake{},
+		newTurnRand(1, 1))
 	require.True(t, (unoccupiedPoint.X+unoccupiedPoint.Y)%2 == 1, "Point coordinates should sum to an odd number %o ", unoccupiedPoint)
 }
 
@@ -84,7 +88,8 @@ func TestGetUnoccupiedPointWithFullBoard(t *testing.T) {
 					{X: 1, Y: 0},
 				},
 			},
-		})
+		},
+		newTurnRand(1, 1))
 	require.True(t, unoccupiedPoint.Equal(nil))
 }
 
@@ -158,6 +163,30 @@ func TestGameTickUpdatesSnake(t *testing.T) {
 	require.Equal(t, &pb.Point{X: 1, Y: 2}, snake.Body[2])
 }
 
+func TestGameTickWrapsSnakes(t *testing.T) {
+	snake := &pb.Snake{
+		Health: 67,
+		Body: []*pb.Point{
+			{X: 1, Y: 0},
+			{X: 1, Y: 1},
+			{X: 1, Y: 2},
+		},
+	}
+	gt, err := GameTick(&pb.Game{
+		Width:   20,
+		Height:  20,
+		Wrapped: true,
+	}, &pb.GameFrame{
+		Turn:   5,
+		Snakes: []*pb.Snake{snake},
+	})
+	require.NoError(t, err)
+	snake = gt.Snakes[0]
+	require.Nil(t, snake.Death)
+	require.Equal(t, &pb.Point{X: 1, Y: 19}, snake.Body[0])
+	require.Equal(t, &pb.Point{X: 1, Y: 0}, snake.Body[1])
+}
+
 var commonGame = &pb.Game{
 	Width:  20,
 	Height: 20,
@@ -289,7 +318,6 @@ func TestCanFollowTail(t *testing.T) {
 }
 
 func TestNextFoodSpawn(t *testing.T) {
-	rand.Seed(1) // random order is 65, 85, 29
 	snakes := []*pb.Snake{
 		{URL: setupSnakeServer(t, MoveResponse{}, StartResponse{})},
 		{URL: setupSnakeServer(t, MoveResponse{}, StartResponse{})},
@@ -299,13 +327,30 @@ func TestNextFoodSpawn(t *testing.T) {
 	next, err := GameTick(&pb.Game{
 		Width:                   20,
 		Height:                  20,
-		TurnsSinceLastFoodSpawn: 5,
 		MaxTurnsToNextFoodSpawn: 5,
 	}, &pb.GameFrame{
-		Snakes: snakes,
+		Snakes:                  snakes,
+		TurnsSinceLastFoodSpawn: 5,
 	})
 	require.NoError(t, err)
 	require.Len(t, next.Food, 2)
+	require.Equal(t, int32(0), next.TurnsSinceLastFoodSpawn)
+}
+
+func TestUpdateFoodCountsTurnsSinceLastSpawn(t *testing.T) {
+	game := &pb.Game{
+		Width:                   20,
+		Height:                  20,
+		MaxTurnsToNextFoodSpawn: 1000,
+	}
+	next := &pb.GameFrame{}
+	err := updateFood(game, &pb.GameFrame{
+		TurnsSinceLastFoodSpawn: 0,
+	}, next, []*pb.Point{}, newTurnRand(1, 1))
+	require.NoError(t, err)
+	require.Len(t, next.Food, 0)
+	require.Equal(t, int32(1), next.TurnsSinceLastFoodSpawn)
+	require.Equal(t, int32(0), game.TurnsSinceLastFoodSpawn, "game should not be modified")
 }
 
 func TestCheckForSnakesEating(t *testing.T) {
@@ -317,12 +362,12 @@ func TestCheckForSnakesEating(t *testing.T) {
 			{X: 2, Y: 2},
 		},
 	}
-	checkForSnakesEating(&pb.GameFrame{
+	checkForSnakesEating(&pb.Game{}, &pb.GameFrame{
 		Food: []*pb.Point{
 			{X: 2, Y: 1},
 		},
 		Snakes: []*pb.Snake{snake},
-	})
+	}, false)
 	require.Len(t, snake.Body, 4)
 	require.Equal(t, snake.Body[2], snake.Body[3])
 }
@@ -336,10 +381,10 @@ func TestCheckForSnakesNotEating(t *testing.T) {
 			{X: 2, Y: 2},
 		},
 	}
-	checkForSnakesEating(&pb.GameFrame{
+	checkForSnakesEating(&pb.Game{}, &pb.GameFrame{
 		Food:   []*pb.Point{},
 		Snakes: []*pb.Snake{snake},
-	})
+	}, false)
 	require.Len(t, snake.Body, 3)
 	require.NotEqual(t, snake.Body[2], snake.Body[1])
 }
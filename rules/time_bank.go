@@ -0,0 +1,71 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+)
+
+// maxTimeBank is the most time a snake can have in its bank, in ms.
+const maxTimeBank = 60000
+
+// validateTimeBank checks the time bank settings in a create request.
+func validateTimeBank(req *pb.CreateRequest) error {
+	if req.TimeBank < 0 {
+		return errors.New("time bank must not be negative")
+	}
+	if req.TimeBank > maxTimeBank {
+		return fmt.Errorf("time bank must be at most %dms", maxTimeBank)
+	}
+	if req.TimeIncrement < 0 {
+		return errors.New("time increment must not be negative")
+	}
+	if req.TimeIncrement > maxSnakeTimeout {
+		return fmt.Errorf("time increment must be at most %dms", maxSnakeTimeout)
+	}
+	if req.TimeIncrement > 0 && req.TimeBank == 0 {
+		return errors.New("time increment needs a time bank")
+	}
+	return nil
+}
+
+// spendTimeBank takes the time a snake took to respond out of its bank. A snake
+// that timed out loses the time it was waited for, which is its whole bank
+// only when the bank was below maxSnakeTimeout. Snakes with time left get the
+// game's time increment added for their next move, a bank never holds more
+// than maxTimeBank.
+func spendTimeBank(game *pb.Game, update *SnakeUpdate) {
+	spent := int32(update.Latency / time.Millisecond)
+	if spent > update.Snake.TimeBank {
+		spent = update.Snake.TimeBank
+	}
+	update.Snake.TimeBank -= spent
+	if update.Snake.TimeBank > 0 {
+		update.Snake.TimeBank += game.TimeIncrement
+	}
+	if update.Snake.TimeBank > maxTimeBank {
+		update.Snake.TimeBank = maxTimeBank
+	}
+}
+
+// timeBankDuration returns how long a snake has to make its move, the time left
+// in its bank but never more than maxSnakeTimeout. A request timeout of 0 means
+// no timeout, so a snake always gets at least a millisecond.
+func timeBankDuration(snake *pb.Snake) time.Duration {
+	bank := snake.TimeBank
+	if bank <= 0 {
+		bank = 1
+	}
+	if bank > maxSnakeTimeout {
+		bank = maxSnakeTimeout
+	}
+	return time.Duration(bank) * time.Millisecond
+}
+
+// deathByTimeBank checks if a snake has run out of time in a game played with a
+// time bank.
+func deathByTimeBank(game *pb.Game, snake *pb.Snake) bool {
+	return game.TimeBank > 0 && snake.TimeBank <= 0
+}
@@ -0,0 +1,122 @@
+package rules
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpendTimeBank(t *testing.T) {
+	game := &pb.Game{TimeBank: 1000, TimeIncrement: 100}
+	snake := &pb.Snake{TimeBank: 1000}
+	spendTimeBank(game, &SnakeUpdate{Snake: snake, Latency: 300 * time.Millisecond})
+	require.Equal(t, int32(800), snake.TimeBank)
+
+	spendTimeBank(game, &SnakeUpdate{Snake: snake, Latency: 2 * time.Second})
+	require.Equal(t, int32(0), snake.TimeBank)
+
+	// a timeout against the capped deadline only takes the time waited
+	snake.TimeBank = 20000
+	spendTimeBank(game, &SnakeUpdate{Snake: snake, Latency: maxSnakeTimeout * time.Millisecond})
+	require.Equal(t, int32(20000-maxSnakeTimeout+100), snake.TimeBank)
+
+	// increments never grow a bank past the limit
+	snake.TimeBank = maxTimeBank
+	spendTimeBank(game, &SnakeUpdate{Snake: snake, Latency: 10 * time.Millisecond})
+	require.Equal(t, int32(maxTimeBank), snake.TimeBank)
+}
+
+func TestTimeoutForTimeBank(t *testing.T) {
+	snake := &pb.Snake{TimeBank: 750}
+	mr := multiSnakeRequest{url: "move", timeout: 200 * time.Millisecond, game: &pb.Game{}}
+	require.Equal(t, 200*time.Millisecond, mr.timeoutFor(snake))
+
+	mr.game.TimeBank = 1000
+	require.Equal(t, 750*time.Millisecond, mr.timeoutFor(snake))
+
+	// a single move never gets longer than the snake timeout limit
+	snake.TimeBank = maxTimeBank
+	require.Equal(t, maxSnakeTimeout*time.Millisecond, mr.timeoutFor(snake))
+	snake.TimeBank = 750
+
+	mr.url = "start"
+	require.Equal(t, 200*time.Millisecond, mr.timeoutFor(snake))
+}
+
+func TestDeathCauseTimeBankExpired(t *testing.T) {
+	updates := checkForDeath(&pb.Game{Width: 10, Height: 10, TimeBank: 1000}, &pb.GameFrame{
+		Turn: 4,
+		Snakes: []*pb.Snake{
+			{ID: "slow", Health: 50, TimeBank: 0, Body: []*pb.Point{{X: 1, Y: 1}}},
+			{ID: "fast", Health: 50, TimeBank: 10, Body: []*pb.Point{{X: 5, Y: 5}}},
+		},
+	}, ruleOptions{})
+	require.Len(t, updates, 1)
+	require.Equal(t, "slow", updates[0].Snake.ID)
+	require.Equal(t, DeathCauseTimeBankExpired, updates[0].Death.Cause)
+}
+
+func TestGameTickTimeBank(t *testing.T) {
+	createClient = getNetClient
+
+	fast := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"move":"up"}`))
+		require.NoError(t, err)
+	}))
+	defer fast.Close()
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		_, err := w.Write([]byte(`{"move":"up"}`))
+		require.NoError(t, err)
+	}))
+	defer slow.Close()
+
+	game := &pb.Game{Width: 10, Height: 10, SnakeTimeout: 5000, TimeBank: 100, TimeIncrement: 50}
+	fastSnake := &pb.Snake{ID: "fast", URL: fast.URL, Health: 50, TimeBank: 100, Body: []*pb.Point{{X: 2, Y: 5}, {X: 2, Y: 6}}}
+	slowSnake := &pb.Snake{ID: "slow", URL: slow.URL, Health: 50, TimeBank: 100, Body: []*pb.Point{{X: 6, Y: 5}, {X: 6, Y: 6}}}
+	_, err := GameTick(game, &pb.GameFrame{
+		Turn:   1,
+		Snakes: []*pb.Snake{fastSnake, slowSnake},
+	})
+	require.NoError(t, err)
+
+	require.Nil(t, fastSnake.Death)
+	require.True(t, fastSnake.TimeBank > 100, "the increment is added after a fast move")
+	require.True(t, fastSnake.TimeBank <= 150)
+
+	require.NotNil(t, slowSnake.Death)
+	require.Equal(t, DeathCauseTimeBankExpired, slowSnake.Death.Cause)
+	require.Equal(t, int32(0), slowSnake.TimeBank)
+}
+
+func TestCreateInitialGameTimeBank(t *testing.T) {
+	g, frames, err := CreateInitialGame(&pb.CreateRequest{
+		Width:         10,
+		Height:        10,
+		TimeBank:      5000,
+		TimeIncrement: 200,
+		Snakes:        []*pb.SnakeOptions{{ID: "1"}, {ID: "2"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(5000), g.TimeBank)
+	require.Equal(t, int32(200), g.TimeIncrement)
+	for _, s := range frames[0].Snakes {
+		require.Equal(t, int32(5000), s.TimeBank)
+	}
+
+	reqs := []*pb.CreateRequest{
+		{TimeBank: -1},
+		{TimeBank: 1000, TimeIncrement: -1},
+		{TimeIncrement: 100},
+		{TimeBank: maxTimeBank + 1},
+		{TimeBank: 1000, TimeIncrement: maxSnakeTimeout + 1},
+	}
+	for _, req := range reqs {
+		_, _, err := CreateInitialGame(req)
+		require.Error(t, err)
+	}
+}
@@ -1,34 +1,65 @@
 package rules
 
-import "github.com/battlesnakeio/engine/controller/pb"
+import (
+	"math/rand"
 
-var (
-	// 7x7 board
-	smallStarts = make([]*pb.Point, 8)
+	"github.com/battlesnakeio/engine/controller/pb"
+)
 
-	// 11x11 board
-	mediumStarts = make([]*pb.Point, 8)
+// minStartSpacing is how many moves apart snakes have to start along the ring.
+const minStartSpacing = 2
 
-	// 19x19 board
-	largeStarts = make([]*pb.Point, 8)
-)
+// tournamentStarts returns fair start points for the snakes on a board without a
+// map. The starts are equally spaced around the ring of cells one in from the
+// edge of the board, using only cells of the same parity so no snake can reach
+// another's start a move earlier. An even number of snakes start in pairs on
+// opposite sides of the board, so the starts look the same when the board is
+// turned half way round. An odd number of snakes start with one snake in the
+// middle of the top of the ring and the rest in pairs mirrored across the
+// middle of the board. On boards where a mirrored start would be on a cell of
+// the other parity the starts are only equally spaced. Which snake gets which
+// start is shuffled. It returns nil when the ring is too small to space the
+// snakes out.
+func tournamentStarts(width, height int32, snakes int, rng *rand.Rand) []*pb.Point {
+	if snakes == 0 || width < 4 || height < 4 {
+		return nil
+	}
+	perimeter := int(2*(width-3) + 2*(height-3))
+	if perimeter < snakes*minStartSpacing {
+		return nil
+	}
 
-func init() {
-	boards := map[int][]*pb.Point{
-		7:  smallStarts,
-		11: mediumStarts,
-		19: largeStarts,
+	// The ring has an even length, so every other cell around it has the
+	// same parity as the first start. The starts are spread equally over
+	// those cells, which keeps them symmetric whenever the board allows.
+	var first int32
+	if snakes%2 == 1 {
+		first = (width-1)/2 - 1
 	}
-	for s, starts := range boards {
-		size := int32(s)
-		center := (size - 1) / 2
-		starts[0] = &pb.Point{X: 1, Y: 1}
-		starts[1] = &pb.Point{X: size - 2, Y: size - 2}
-		starts[2] = &pb.Point{X: 1, Y: size - 2}
-		starts[3] = &pb.Point{X: size - 2, Y: 1}
-		starts[4] = &pb.Point{X: center, Y: 1}
-		starts[5] = &pb.Point{X: size - 2, Y: center}
-		starts[6] = &pb.Point{X: center, Y: size - 2}
-		starts[7] = &pb.Point{X: 1, Y: center}
+	cells := perimeter / 2
+	starts := make([]*pb.Point, snakes)
+	for i := range starts {
+		step := int32((2*i*cells + snakes) / (2 * snakes))
+		starts[i] = ringPoint(width, height, first+2*step)
+	}
+	rng.Shuffle(len(starts), func(i, j int) {
+		starts[i], starts[j] = starts[j], starts[i]
+	})
+	return starts
+}
+
+// ringPoint returns the point the given distance clockwise around the ring of
+// cells one in from the edge of the board, starting from the top left corner.
+func ringPoint(width, height, d int32) *pb.Point {
+	across, down := width-3, height-3
+	d %= 2 * (across + down)
+	switch {
+	case d < across:
+		return &pb.Point{X: 1 + d, Y: 1}
+	case d < across+down:
+		return &pb.Point{X: width - 2, Y: 1 + d - across}
+	case d < 2*across+down:
+		return &pb.Point{X: width - 2 - (d - across - down), Y: height - 2}
 	}
+	return &pb.Point{X: 1, Y: height - 2 - (d - 2*across - down)}
 }
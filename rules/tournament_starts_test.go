@@ -0,0 +1,98 @@
+package rules
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTournamentStartsSymmetric(t *testing.T) {
+	for _, size := range [][2]int32{{7, 7}, {11, 11}, {19, 19}, {10, 15}, {25, 9}, {12, 12}, {8, 11}, {4, 5}} {
+		width, height := size[0], size[1]
+		for snakes := 1; snakes <= 16; snakes++ {
+			name := fmt.Sprintf("%dx%d with %d snakes", width, height, snakes)
+			starts := tournamentStarts(width, height, snakes, newTurnRand(1, 0))
+			if int(2*(width-3)+2*(height-3)) < snakes*minStartSpacing {
+				require.Nil(t, starts, name)
+				continue
+			}
+			require.Len(t, starts, snakes, name)
+			for i, s := range starts {
+				require.False(t, containsPoint(starts[:i], s), name)
+				onRing := s.X == 1 || s.X == width-2 || s.Y == 1 || s.Y == height-2
+				require.True(t, onRing && s.X >= 1 && s.X <= width-2 && s.Y >= 1 && s.Y <= height-2, name)
+
+				require.Equal(t, (starts[0].X+starts[0].Y)%2, (s.X+s.Y)%2, name)
+
+				if snakes%2 == 0 && (width+height)%2 == 0 {
+					require.True(t, containsPoint(starts, &pb.Point{X: width - 1 - s.X, Y: height - 1 - s.Y}), name)
+				} else if width%2 == 1 {
+					require.True(t, containsPoint(starts, &pb.Point{X: width - 1 - s.X, Y: s.Y}), name)
+				}
+			}
+		}
+	}
+}
+
+func TestTournamentStartsEquallySpaced(t *testing.T) {
+	// Eight snakes on a square board start in the corners and the middle of
+	// each side.
+	starts := tournamentStarts(11, 11, 8, newTurnRand(1, 0))
+	require.ElementsMatch(t, []*pb.Point{
+		{X: 1, Y: 1}, {X: 5, Y: 1}, {X: 9, Y: 1}, {X: 9, Y: 5},
+		{X: 9, Y: 9}, {X: 5, Y: 9}, {X: 1, Y: 9}, {X: 1, Y: 5},
+	}, starts)
+
+	// Three snakes on a rectangular board, all on cells of the same parity.
+	starts = tournamentStarts(9, 7, 3, newTurnRand(1, 0))
+	require.ElementsMatch(t, []*pb.Point{{X: 4, Y: 1}, {X: 7, Y: 4}, {X: 1, Y: 4}}, starts)
+}
+
+func TestTournamentStartsShuffledBySeed(t *testing.T) {
+	require.Equal(t,
+		tournamentStarts(19, 19, 16, newTurnRand(42, 0)),
+		tournamentStarts(19, 19, 16, newTurnRand(42, 0)))
+
+	shuffled := false
+	for seed := int64(1); seed < 10 && !shuffled; seed++ {
+		shuffled = !pointsEqual(
+			tournamentStarts(19, 19, 16, newTurnRand(42, 0)),
+			tournamentStarts(19, 19, 16, newTurnRand(42+seed, 0)))
+	}
+	require.True(t, shuffled)
+}
+
+func TestTournamentStartsTooSmall(t *testing.T) {
+	require.Nil(t, tournamentStarts(3, 3, 2, newTurnRand(1, 0)))
+	require.Nil(t, tournamentStarts(7, 7, 9, newTurnRand(1, 0)))
+	require.Nil(t, tournamentStarts(11, 11, 0, newTurnRand(1, 0)))
+}
+
+func TestCreateInitialGameTournamentStarts(t *testing.T) {
+	req := &pb.CreateRequest{Width: 21, Height: 13, Seed: 7}
+	for i := 0; i < 12; i++ {
+		req.Snakes = append(req.Snakes, &pb.SnakeOptions{ID: fmt.Sprintf("snake-%d", i)})
+	}
+	_, frames, err := CreateInitialGame(req)
+	require.NoError(t, err)
+
+	heads := []*pb.Point{}
+	for _, s := range frames[0].Snakes {
+		heads = append(heads, s.Head())
+	}
+	require.ElementsMatch(t, tournamentStarts(21, 13, 12, newTurnRand(7, 0)), heads)
+}
+
+func pointsEqual(a, b []*pb.Point) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,97 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+)
+
+const (
+	// VisionMetricManhattan measures vision in moves, the sum of the distances
+	// along each axis
+	VisionMetricManhattan = "manhattan"
+	// VisionMetricChebyshev measures vision as the larger of the distances
+	// along each axis, so snakes see a square around their head
+	VisionMetricChebyshev = "chebyshev"
+)
+
+// validateVision checks the vision settings in a create request.
+func validateVision(req *pb.CreateRequest) error {
+	if req.VisionRadius < 0 {
+		return errors.New("vision radius must not be negative")
+	}
+	switch req.VisionMetric {
+	case "", VisionMetricManhattan, VisionMetricChebyshev:
+		return nil
+	}
+	return fmt.Errorf("rules: unknown vision metric %q", req.VisionMetric)
+}
+
+// visionMetric returns the vision metric of a game, defaulting to manhattan.
+func visionMetric(game *pb.Game) string {
+	if game.VisionMetric == "" {
+		return VisionMetricManhattan
+	}
+	return game.VisionMetric
+}
+
+// visionDistance returns how far apart two points are for the game's vision
+// metric.
+func visionDistance(game *pb.Game, a, b *pb.Point) int32 {
+	dx, dy := axisDistances(game, a, b)
+	if game.VisionMetric == VisionMetricChebyshev {
+		if dx > dy {
+			return dx
+		}
+		return dy
+	}
+	return dx + dy
+}
+
+// visibleFrame returns the frame as seen by a snake. In games with a vision
+// radius only the food and snake segments within the radius of the snake's head
+// are kept, snakes with no segments in sight are left out. The snake always
+// sees all of itself. The frame passed in is not changed.
+func visibleFrame(game *pb.Game, frame *pb.GameFrame, you *pb.Snake) *pb.GameFrame {
+	if game.VisionRadius <= 0 || you == nil || you.Head() == nil {
+		return frame
+	}
+	head := you.Head()
+	inSight := func(p *pb.Point) bool {
+		return visionDistance(game, head, p) <= game.VisionRadius
+	}
+
+	visible := &pb.GameFrame{
+		Turn:      frame.Turn,
+		Hazards:   frame.Hazards,
+		Walls:     frame.Walls,
+		FoodItems: frame.FoodItems,
+		Food:      []*pb.Point{},
+		Snakes:    []*pb.Snake{},
+	}
+	for _, f := range frame.Food {
+		if inSight(f) {
+			visible.Food = append(visible.Food, f)
+		}
+	}
+	for _, s := range frame.Snakes {
+		if s.ID == you.ID {
+			visible.Snakes = append(visible.Snakes, s)
+			continue
+		}
+		body := []*pb.Point{}
+		for _, b := range s.Body {
+			if inSight(b) {
+				body = append(body, b)
+			}
+		}
+		if len(body) == 0 {
+			continue
+		}
+		seen := *s
+		seen.Body = body
+		visible.Snakes = append(visible.Snakes, &seen)
+	}
+	return visible
+}
@@ -0,0 +1,70 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/battlesnakeio/engine/controller/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func visionTestFrame() *pb.GameFrame {
+	return &pb.GameFrame{
+		Food: []*pb.Point{{X: 2, Y: 2}, {X: 4, Y: 4}, {X: 9, Y: 9}},
+		Snakes: []*pb.Snake{
+			{ID: "you", Health: 90, Body: []*pb.Point{{X: 2, Y: 4}, {X: 2, Y: 5}, {X: 2, Y: 6}, {X: 2, Y: 7}}},
+			{ID: "near", Health: 80, Body: []*pb.Point{{X: 5, Y: 4}, {X: 6, Y: 4}, {X: 7, Y: 4}}},
+			{ID: "far", Health: 70, Body: []*pb.Point{{X: 9, Y: 0}, {X: 9, Y: 1}}},
+		},
+	}
+}
+
+func TestBuildSnakeRequestFullVision(t *testing.T) {
+	req := buildSnakeRequest(&pb.Game{Width: 10, Height: 10}, visionTestFrame(), "you")
+	require.Len(t, req.Board.Food, 3)
+	require.Len(t, req.Board.Snakes, 3)
+}
+
+func TestBuildSnakeRequestManhattanVision(t *testing.T) {
+	frame := visionTestFrame()
+	req := buildSnakeRequest(&pb.Game{Width: 10, Height: 10, VisionRadius: 3}, frame, "you")
+	require.Equal(t, []Food{{X: 2, Y: 2, Type: FoodTypeNormal}, {X: 4, Y: 4, Type: FoodTypeNormal}}, req.Board.Food)
+	require.Len(t, req.Board.Snakes, 2)
+	require.Len(t, req.Board.Snakes[0].Body, 4, "snakes always see all of themselves")
+	require.Len(t, req.You.Body, 4)
+	require.Equal(t, "near", req.Board.Snakes[1].ID)
+	require.Equal(t, []Coords{{X: 5, Y: 4}}, req.Board.Snakes[1].Body)
+	require.Equal(t, int32(80), req.Board.Snakes[1].Health)
+
+	// the stored frame keeps everything
+	require.Len(t, frame.Food, 3)
+	require.Len(t, frame.Snakes[1].Body, 3)
+}
+
+func TestBuildSnakeRequestChebyshevVision(t *testing.T) {
+	game := &pb.Game{Width: 10, Height: 10, VisionRadius: 3, VisionMetric: VisionMetricChebyshev}
+	req := buildSnakeRequest(game, visionTestFrame(), "you")
+	require.Len(t, req.Board.Snakes, 2)
+	require.Equal(t, []Coords{{X: 5, Y: 4}}, req.Board.Snakes[1].Body)
+	require.Len(t, req.Board.Food, 2)
+
+	game.VisionRadius = 5
+	req = buildSnakeRequest(game, visionTestFrame(), "you")
+	require.Equal(t, []Coords{{X: 5, Y: 4}, {X: 6, Y: 4}, {X: 7, Y: 4}}, req.Board.Snakes[1].Body)
+}
+
+func TestVisionOnWrappedBoard(t *testing.T) {
+	game := &pb.Game{Width: 10, Height: 10, Wrapped: true, VisionRadius: 2}
+	require.Equal(t, int32(2), visionDistance(game, &pb.Point{X: 0, Y: 0}, &pb.Point{X: 9, Y: 9}))
+}
+
+func TestCreateInitialGameVision(t *testing.T) {
+	g, _, err := CreateInitialGame(&pb.CreateRequest{Width: 10, Height: 10, VisionRadius: 4})
+	require.NoError(t, err)
+	require.Equal(t, int32(4), g.VisionRadius)
+	require.Equal(t, VisionMetricManhattan, g.VisionMetric)
+
+	_, _, err = CreateInitialGame(&pb.CreateRequest{VisionRadius: -1})
+	require.Error(t, err)
+	_, _, err = CreateInitialGame(&pb.CreateRequest{VisionMetric: "euclidean"})
+	require.Error(t, err)
+}
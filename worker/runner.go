@@ -44,8 +44,21 @@ func Runner(ctx context.Context, client pb.ControllerClient, id string) error {
 	}
 	lastFrame := resp.LastFrame
 
+	ruleset, err := rules.GetRuleset(resp.Game.Ruleset)
+	if err != nil {
+		log.WithError(err).
+			WithField("game", id).
+			Error("ending game due to unknown ruleset")
+		if _, endErr := client.EndGame(ctx, &pb.EndGameRequest{ID: resp.Game.ID}); endErr != nil {
+			log.WithError(endErr).
+				WithField("game", id).
+				Error("failed to end game after unknown ruleset")
+		}
+		return err
+	}
+
 	for {
-		nextFrame, err := rules.GameTick(resp.Game, lastFrame)
+		nextFrame, err := ruleset.GameTick(resp.Game, lastFrame)
 		if err != nil {
 			// This is a GameFrame error, we can assume that this is a fatal
 			// error and no more game processing can take place at this point.
@@ -73,12 +86,15 @@ func Runner(ctx context.Context, client pb.ControllerClient, id string) error {
 			return err
 		}
 
-		if rules.CheckForGameOver(rules.GameMode(resp.Game.Mode), nextFrame) {
+		if ruleset.CheckForGameOver(resp.Game, nextFrame) {
 			log.WithField("GameID", id).
 				WithField("Turn", nextFrame.Turn).
 				Info("ending game")
 			rules.NotifyGameEnd(resp.Game, nextFrame)
-			_, err := client.EndGame(ctx, &pb.EndGameRequest{ID: resp.Game.ID})
+			_, err := client.EndGame(ctx, &pb.EndGameRequest{
+				ID:     resp.Game.ID,
+				Result: rules.GetGameResult(resp.Game, nextFrame),
+			})
 			if err != nil {
 				log.WithError(err).WithField("GameID", id).Error("Error while ending game")
 			}
@@ -167,3 +167,101 @@ func TestWorker_Runner(t *testing.T) {
 		})
 	}
 }
+
+func TestWorker_RunnerResumesFoodSpawnCounter(t *testing.T) {
+	client, store := server()
+	ctx := context.Background()
+
+	err := store.CreateGame(ctx,
+		&pb.Game{
+			ID:                      "resume",
+			Status:                  string(rules.GameStatusRunning),
+			Width:                   5,
+			Height:                  5,
+			Mode:                    string(rules.GameModeSinglePlayer),
+			Seed:                    1,
+			MaxTurnsToNextFoodSpawn: 1000,
+		},
+		[]*pb.GameFrame{{
+			Turn:                    0,
+			TurnsSinceLastFoodSpawn: 7,
+			Snakes: []*pb.Snake{{
+				ID:     "1",
+				URL:    snakeURL,
+				Health: 100,
+				Body:   []*pb.Point{{X: 2, Y: 4}, {X: 2, Y: 4}, {X: 2, Y: 4}},
+			}},
+		}},
+	)
+	require.NoError(t, err)
+
+	w := &Worker{
+		ControllerClient: client,
+		PollInterval:     1 * time.Millisecond,
+		RunGame:          Runner,
+	}
+	err = w.run(ctx, 1)
+	require.NoError(t, err)
+
+	frames, err := store.ListGameFrames(ctx, "resume", 10, 0)
+	require.NoError(t, err)
+	require.True(t, len(frames) > 2)
+	require.Equal(t, int32(8), frames[1].TurnsSinceLastFoodSpawn)
+	require.Equal(t, int32(9), frames[2].TurnsSinceLastFoodSpawn)
+}
+
+func TestWorker_RunnerStopsAtMaxTurns(t *testing.T) {
+	client, store := server()
+	ctx := context.Background()
+
+	err := store.CreateGame(ctx,
+		&pb.Game{
+			ID:         "max-turns",
+			Status:     string(rules.GameStatusRunning),
+			Width:      11,
+			Height:     11,
+			Mode:       string(rules.GameModeMultiPlayer),
+			Seed:       1,
+			MaxTurns:   3,
+			Tiebreaker: rules.TiebreakerLongest,
+		},
+		[]*pb.GameFrame{{
+			Turn: 0,
+			Snakes: []*pb.Snake{
+				{
+					ID:     "1",
+					URL:    snakeURL,
+					Health: 100,
+					Body:   []*pb.Point{{X: 2, Y: 9}, {X: 2, Y: 9}, {X: 2, Y: 9}, {X: 2, Y: 9}},
+				},
+				{
+					ID:     "2",
+					URL:    snakeURL,
+					Health: 100,
+					Body:   []*pb.Point{{X: 8, Y: 9}, {X: 8, Y: 9}, {X: 8, Y: 9}},
+				},
+			},
+		}},
+	)
+	require.NoError(t, err)
+
+	w := &Worker{
+		ControllerClient: client,
+		PollInterval:     1 * time.Millisecond,
+		RunGame:          Runner,
+	}
+	err = w.run(ctx, 1)
+	require.NoError(t, err)
+
+	frames, err := store.ListGameFrames(ctx, "max-turns", 10, 0)
+	require.NoError(t, err)
+	require.Len(t, frames, 4)
+
+	g, err := store.GetGame(ctx, "max-turns")
+	require.NoError(t, err)
+	require.Equal(t, string(rules.GameStatusComplete), g.Status)
+	require.Equal(t, &pb.GameResult{
+		Winners: []string{"1"},
+		Reason:  rules.ResultReasonMaxTurns,
+	}, g.Result)
+}